@@ -22,6 +22,7 @@ import (
 	"github.com/IBM/fp-go/internal/array"
 	M "github.com/IBM/fp-go/monoid"
 	O "github.com/IBM/fp-go/option"
+	P "github.com/IBM/fp-go/predicate"
 	"github.com/IBM/fp-go/tuple"
 )
 
@@ -40,6 +41,12 @@ func Replicate[A any](n int, a A) []A {
 	return G.Replicate[[]A](n, a)
 }
 
+// Unfold creates an array from a seed value. The generator function returns the next element and the next seed
+// or [O.None] to stop the generation, so it must eventually return [O.None] to terminate
+func Unfold[F ~func(B) O.Option[tuple.Tuple2[A, B]], A, B any](f F, seed B) []A {
+	return G.Unfold[[]A](f, seed)
+}
+
 func MonadMap[A, B any](as []A, f func(a A) B) []B {
 	return G.MonadMap[[]A, []B](as, f)
 }
@@ -116,6 +123,12 @@ func FilterMap[A, B any](f func(A) O.Option[B]) func([]A) []B {
 	return G.FilterMap[[]A, []B](f)
 }
 
+// FlatMapOption maps an array with an iterating function that returns an [O.Option] and it keeps only the Some values discarding the Nones.
+// It is an alias for [FilterMap].
+func FlatMapOption[A, B any](f func(A) O.Option[B]) func([]A) []B {
+	return FilterMap(f)
+}
+
 // FilterMapWithIndex maps an array with an iterating function that returns an [O.Option] and it keeps only the Some values discarding the Nones.
 func FilterMapWithIndex[A, B any](f func(int, A) O.Option[B]) func([]A) []B {
 	return G.FilterMapWithIndex[[]A, []B](f)
@@ -157,6 +170,18 @@ func ReduceRightWithIndex[A, B any](f func(int, A, B) B, initial B) func([]A) B
 	return G.ReduceRightWithIndex[[]A](f, initial)
 }
 
+// ScanLeft is like [Reduce] but returns all intermediate results. The result has one element more than
+// the input, the first element is the initial value and the last element is the result of [Reduce]
+func ScanLeft[A, B any](f func(B, A) B, initial B) func([]A) []B {
+	return G.ScanLeft[[]A, []B](f, initial)
+}
+
+// ScanRight is like [ReduceRight] but returns all intermediate results. The result has one element more than
+// the input, the last element is the initial value and the first element is the result of [ReduceRight]
+func ScanRight[A, B any](f func(A, B) B, initial B) func([]A) []B {
+	return G.ScanRight[[]A, []B](f, initial)
+}
+
 func ReduceRef[A, B any](f func(B, *A) B, initial B) func([]A) B {
 	return func(as []A) B {
 		return reduceRef(as, f, initial)
@@ -196,6 +221,16 @@ func Chain[A, B any](f func(A) []B) func([]A) []B {
 	return G.Chain[[]A, []B](f)
 }
 
+// MonadChainWithIndex maps each element and its index to an array and concatenates the results
+func MonadChainWithIndex[A, B any](fa []A, f func(int, A) []B) []B {
+	return G.MonadChainWithIndex[[]A, []B](fa, f)
+}
+
+// ChainWithIndex maps each element and its index to an array and concatenates the results
+func ChainWithIndex[A, B any](f func(int, A) []B) func([]A) []B {
+	return G.ChainWithIndex[[]A, []B](f)
+}
+
 func MonadAp[B, A any](fab []func(A) B, fa []A) []B {
 	return G.MonadAp[[]B](fab, fa)
 }
@@ -260,6 +295,7 @@ func Intercalate[A any](m M.Monoid[A]) func(A) func([]A) A {
 	}
 }
 
+// Flatten concatenates an array of arrays into a single array, it removes exactly one level of nesting
 func Flatten[A any](mma [][]A) []A {
 	return G.Flatten(mma)
 }
@@ -349,3 +385,30 @@ func Flap[B, A any](a A) func([]func(A) B) []B {
 func Prepend[A any](head A) EM.Endomorphism[[]A] {
 	return G.Prepend[EM.Endomorphism[[]A]](head)
 }
+
+// Reverse returns a new array with the elements in reverse order
+func Reverse[A any](as []A) []A {
+	return G.Reverse(as)
+}
+
+// Rotate returns a new array with the elements cyclically shifted by n positions. A positive n shifts the
+// elements to the left, a negative n to the right. The shift is taken modulo the length of the array
+func Rotate[A any](n int) func([]A) []A {
+	return G.Rotate[[]A](n)
+}
+
+// SplitAt splits an array into an array with the first n elements and an array with the remaining elements.
+// If n is out of range it is clamped to the bounds of the array
+func SplitAt[A any](n int) func([]A) tuple.Tuple2[[]A, []A] {
+	return G.SplitAt[[]A](n)
+}
+
+// Span splits an array into the longest prefix of elements that satisfy the predicate and the remaining elements
+func Span[A any](pred func(A) bool) func([]A) tuple.Tuple2[[]A, []A] {
+	return G.Span[[]A](pred)
+}
+
+// Break splits an array into the longest prefix of elements that do not satisfy the predicate and the remaining elements
+func Break[A any](pred func(A) bool) func([]A) tuple.Tuple2[[]A, []A] {
+	return G.Span[[]A](P.Not(pred))
+}
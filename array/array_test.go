@@ -124,6 +124,20 @@ func TestPrependAll(t *testing.T) {
 
 func TestFlatten(t *testing.T) {
 	assert.Equal(t, []int{1, 2, 3}, Flatten([][]int{{1}, {2}, {3}}))
+	// only one level is removed
+	assert.Equal(t, [][]int{{1}, {2, 3}}, Flatten([][][]int{{{1}}, {{2, 3}}}))
+}
+
+func TestFlatMapOption(t *testing.T) {
+	f := FlatMapOption(func(i int) O.Option[string] {
+		if i%2 != 0 {
+			return O.Of(fmt.Sprintf("a%d", i))
+		}
+		return O.None[string]()
+	})
+
+	assert.Equal(t, From("a1", "a3"), f(From(1, 2, 3)))
+	assert.Equal(t, []string{}, f(From(2, 4)))
 }
 
 func TestLookup(t *testing.T) {
@@ -153,6 +167,10 @@ func TestPartition(t *testing.T) {
 
 	assert.Equal(t, T.MakeTuple2(Empty[int](), Empty[int]()), Partition(pred)(Empty[int]()))
 	assert.Equal(t, T.MakeTuple2(From(1), From(3)), Partition(pred)(From(1, 3)))
+	// order is preserved, all or none matching
+	assert.Equal(t, T.MakeTuple2(From(2, 1), From(4, 3)), Partition(pred)(From(4, 2, 3, 1)))
+	assert.Equal(t, T.MakeTuple2(Empty[int](), From(4, 3)), Partition(pred)(From(4, 3)))
+	assert.Equal(t, T.MakeTuple2(From(2, 1), Empty[int]()), Partition(pred)(From(2, 1)))
 }
 
 func TestFilterChain(t *testing.T) {
@@ -203,3 +221,108 @@ func ExampleFoldMap() {
 	// Output: ABC
 
 }
+
+func TestScanLeft(t *testing.T) {
+	sum := func(b, a int) int {
+		return b + a
+	}
+
+	assert.Equal(t, From(0, 1, 3, 6), ScanLeft(sum, 0)(From(1, 2, 3)))
+	assert.Equal(t, From(0), ScanLeft(sum, 0)(Empty[int]()))
+}
+
+func TestScanRight(t *testing.T) {
+	sum := func(a, b int) int {
+		return a + b
+	}
+
+	assert.Equal(t, From(6, 5, 3, 0), ScanRight(sum, 0)(From(1, 2, 3)))
+	assert.Equal(t, From(0), ScanRight(sum, 0)(Empty[int]()))
+}
+
+func TestUnfold(t *testing.T) {
+	// fibonacci numbers up to 50
+	fib := func(s T.Tuple2[int, int]) O.Option[T.Tuple2[int, T.Tuple2[int, int]]] {
+		if s.F1 > 50 {
+			return O.None[T.Tuple2[int, T.Tuple2[int, int]]]()
+		}
+		return O.Some(T.MakeTuple2(s.F1, T.MakeTuple2(s.F2, s.F1+s.F2)))
+	}
+
+	assert.Equal(t, From(0, 1, 1, 2, 3, 5, 8, 13, 21, 34), Unfold(fib, T.MakeTuple2(0, 1)))
+
+	// digits of a number
+	digits := func(n int) O.Option[T.Tuple2[int, int]] {
+		if n == 0 {
+			return O.None[T.Tuple2[int, int]]()
+		}
+		return O.Some(T.MakeTuple2(n%10, n/10))
+	}
+
+	assert.Equal(t, From(3, 2, 1), Unfold(digits, 123))
+	assert.Equal(t, Empty[int](), Unfold(digits, 0))
+}
+
+func TestReverse(t *testing.T) {
+	data := From(1, 2, 3)
+
+	assert.Equal(t, From(3, 2, 1), Reverse(data))
+	assert.Equal(t, From(1, 2, 3), data)
+	assert.Equal(t, Empty[int](), Reverse(Empty[int]()))
+}
+
+func TestRotate(t *testing.T) {
+	data := From(1, 2, 3, 4)
+
+	assert.Equal(t, From(2, 3, 4, 1), Rotate[int](1)(data))
+	assert.Equal(t, From(4, 1, 2, 3), Rotate[int](-1)(data))
+	// shifts larger than the length
+	assert.Equal(t, From(3, 4, 1, 2), Rotate[int](6)(data))
+	assert.Equal(t, From(2, 3, 4, 1), Rotate[int](-7)(data))
+	assert.Equal(t, From(1, 2, 3, 4), Rotate[int](0)(data))
+	assert.Equal(t, Empty[int](), Rotate[int](3)(Empty[int]()))
+	// the input is not modified
+	assert.Equal(t, From(1, 2, 3, 4), data)
+}
+
+func TestSplitAt(t *testing.T) {
+	data := From(1, 2, 3)
+
+	assert.Equal(t, T.MakeTuple2(From(1), From(2, 3)), SplitAt[int](1)(data))
+	// boundaries
+	assert.Equal(t, T.MakeTuple2(Empty[int](), From(1, 2, 3)), SplitAt[int](0)(data))
+	assert.Equal(t, T.MakeTuple2(From(1, 2, 3), Empty[int]()), SplitAt[int](3)(data))
+	// out of range
+	assert.Equal(t, T.MakeTuple2(Empty[int](), From(1, 2, 3)), SplitAt[int](-1)(data))
+	assert.Equal(t, T.MakeTuple2(From(1, 2, 3), Empty[int]()), SplitAt[int](4)(data))
+}
+
+func TestSpan(t *testing.T) {
+	isSmall := func(n int) bool {
+		return n < 3
+	}
+
+	assert.Equal(t, T.MakeTuple2(From(1, 2), From(3, 1)), Span(isSmall)(From(1, 2, 3, 1)))
+	assert.Equal(t, T.MakeTuple2(From(1, 2), Empty[int]()), Span(isSmall)(From(1, 2)))
+	assert.Equal(t, T.MakeTuple2(Empty[int](), From(3, 1)), Span(isSmall)(From(3, 1)))
+	assert.Equal(t, T.MakeTuple2(Empty[int](), Empty[int]()), Span(isSmall)(Empty[int]()))
+}
+
+func TestBreak(t *testing.T) {
+	isSmall := func(n int) bool {
+		return n < 3
+	}
+
+	assert.Equal(t, T.MakeTuple2(From(3, 4), From(1, 5)), Break(isSmall)(From(3, 4, 1, 5)))
+	assert.Equal(t, T.MakeTuple2(Empty[int](), From(1, 5)), Break(isSmall)(From(1, 5)))
+}
+
+func TestChainWithIndex(t *testing.T) {
+	// repeat each element according to its position
+	repeat := ChainWithIndex(func(idx int, s string) []string {
+		return Replicate(idx+1, s)
+	})
+
+	assert.Equal(t, []string{"a", "b", "b", "c", "c", "c"}, repeat([]string{"a", "b", "c"}))
+	assert.Equal(t, []string{}, repeat(Empty[string]()))
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	G "github.com/IBM/fp-go/array/generic"
+	T "github.com/IBM/fp-go/tuple"
+)
+
+// Chunk splits an array into consecutive chunks of at most the given size, the last chunk may be shorter.
+// A size less than or equal to zero results in an empty array
+func Chunk[A any](size int) func([]A) [][]A {
+	return G.Chunk[[][]A, []A](size)
+}
+
+// Windows returns all sliding windows of the given size. Windows at the end of the array that would be shorter than size
+// are omitted, so a size less than or equal to zero or greater than the length of the array results in an empty array
+func Windows[A any](size int) func([]A) [][]A {
+	return G.Windows[[][]A, []A](size)
+}
+
+// Pairwise returns the pairs of adjacent elements, e.g. to compute the deltas between consecutive elements.
+// Arrays with less than two elements result in an empty array
+func Pairwise[A any](as []A) []T.Tuple2[A, A] {
+	return G.Pairwise[[]A, []T.Tuple2[A, A]](as)
+}
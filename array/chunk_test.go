@@ -0,0 +1,59 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"testing"
+
+	T "github.com/IBM/fp-go/tuple"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunk(t *testing.T) {
+	// exact fit
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}}, Chunk[int](2)(From(1, 2, 3, 4)))
+	// remainder
+	assert.Equal(t, [][]int{{1, 2, 3}, {4, 5}}, Chunk[int](3)(From(1, 2, 3, 4, 5)))
+	// edge cases
+	assert.Equal(t, [][]int{}, Chunk[int](2)(Empty[int]()))
+	assert.Equal(t, [][]int{}, Chunk[int](0)(From(1, 2)))
+}
+
+func TestWindows(t *testing.T) {
+	// exact fit
+	assert.Equal(t, [][]int{{1, 2, 3}}, Windows[int](3)(From(1, 2, 3)))
+	// sliding
+	assert.Equal(t, [][]int{{1, 2}, {2, 3}, {3, 4}}, Windows[int](2)(From(1, 2, 3, 4)))
+	// edge cases
+	assert.Equal(t, [][]int{}, Windows[int](3)(From(1, 2)))
+	assert.Equal(t, [][]int{}, Windows[int](0)(From(1, 2)))
+}
+
+func TestChunkDoesNotShare(t *testing.T) {
+	data := From(1, 2, 3)
+	chunks := Chunk[int](2)(data)
+
+	chunks[0][0] = 10
+
+	assert.Equal(t, From(1, 2, 3), data)
+}
+
+func TestPairwise(t *testing.T) {
+	assert.Equal(t, []T.Tuple2[int, int]{T.MakeTuple2(1, 2), T.MakeTuple2(2, 3)}, Pairwise(From(1, 2, 3)))
+	// edge cases
+	assert.Equal(t, []T.Tuple2[int, int]{}, Pairwise(Empty[int]()))
+	assert.Equal(t, []T.Tuple2[int, int]{}, Pairwise(From(1)))
+}
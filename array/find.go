@@ -59,3 +59,13 @@ func FindLastMap[A, B any](sel func(A) O.Option[B]) func([]A) O.Option[B] {
 func FindLastMapWithIndex[A, B any](sel func(int, A) O.Option[B]) func([]A) O.Option[B] {
 	return G.FindLastMapWithIndex[[]A](sel)
 }
+
+// FindIndex finds the index of the first element which satisfies a predicate function
+func FindIndex[A any](pred func(A) bool) func([]A) O.Option[int] {
+	return G.FindIndex[[]A](pred)
+}
+
+// FindLastIndex finds the index of the last element which satisfies a predicate function
+func FindLastIndex[A any](pred func(A) bool) func([]A) O.Option[int] {
+	return G.FindLastIndex[[]A](pred)
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"testing"
+
+	O "github.com/IBM/fp-go/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindIndex(t *testing.T) {
+	isEven := func(n int) bool {
+		return n%2 == 0
+	}
+
+	assert.Equal(t, O.Some(1), FindIndex(isEven)(From(1, 2, 3, 4)))
+	assert.Equal(t, O.None[int](), FindIndex(isEven)(From(1, 3)))
+	assert.Equal(t, O.None[int](), FindIndex(isEven)(Empty[int]()))
+}
+
+func TestFindLastIndex(t *testing.T) {
+	isEven := func(n int) bool {
+		return n%2 == 0
+	}
+
+	assert.Equal(t, O.Some(3), FindLastIndex(isEven)(From(1, 2, 3, 4, 5)))
+	assert.Equal(t, O.None[int](), FindLastIndex(isEven)(From(1, 3)))
+	assert.Equal(t, O.None[int](), FindLastIndex(isEven)(Empty[int]()))
+}
@@ -53,6 +53,32 @@ func ReduceRightWithIndex[GA ~[]A, A, B any](f func(int, A, B) B, initial B) fun
 	}
 }
 
+// ScanLeft is like [Reduce] but returns all intermediate results, starting with the initial value
+func ScanLeft[GA ~[]A, GB ~[]B, A, B any](f func(B, A) B, initial B) func(GA) GB {
+	return func(as GA) GB {
+		count := len(as)
+		result := make(GB, count+1)
+		result[0] = initial
+		for i := 0; i < count; i++ {
+			result[i+1] = f(result[i], as[i])
+		}
+		return result
+	}
+}
+
+// ScanRight is like [ReduceRight] but returns all intermediate results, ending with the initial value
+func ScanRight[GA ~[]A, GB ~[]B, A, B any](f func(A, B) B, initial B) func(GA) GB {
+	return func(as GA) GB {
+		count := len(as)
+		result := make(GB, count+1)
+		result[count] = initial
+		for i := count - 1; i >= 0; i-- {
+			result[i] = f(as[i], result[i+1])
+		}
+		return result
+	}
+}
+
 func MonadReduce[GA ~[]A, A, B any](fa GA, f func(B, A) B, initial B) B {
 	return array.Reduce(fa, f, initial)
 }
@@ -88,6 +114,16 @@ func MakeBy[AS ~[]A, F ~func(int) A, A any](n int, f F) AS {
 	return as
 }
 
+// Unfold creates an array from a seed value. The generator function returns the next element and the next seed
+// or [O.None] to stop the generation, so it must eventually return [O.None] to terminate
+func Unfold[AS ~[]A, F ~func(B) O.Option[tuple.Tuple2[A, B]], A, B any](f F, seed B) AS {
+	as := Empty[AS]()
+	for t, ok := O.Unwrap(f(seed)); ok; t, ok = O.Unwrap(f(t.F2)) {
+		as = append(as, t.F1)
+	}
+	return as
+}
+
 func Replicate[AS ~[]A, A any](n int, a A) AS {
 	return MakeBy[AS](n, F.Constant1[int](a))
 }
@@ -248,6 +284,16 @@ func Chain[AS ~[]A, BS ~[]B, A, B any](f func(A) BS) func(AS) BS {
 	return F.Bind2nd(MonadChain[AS, BS, A, B], f)
 }
 
+func MonadChainWithIndex[AS ~[]A, BS ~[]B, A, B any](fa AS, f func(int, A) BS) BS {
+	return array.ReduceWithIndex(fa, func(idx int, bs BS, a A) BS {
+		return append(bs, f(idx, a)...)
+	}, Empty[BS]())
+}
+
+func ChainWithIndex[AS ~[]A, BS ~[]B, A, B any](f func(int, A) BS) func(AS) BS {
+	return F.Bind2nd(MonadChainWithIndex[AS, BS, A, B], f)
+}
+
 func MonadAp[BS ~[]B, ABS ~[]func(A) B, AS ~[]A, B, A any](fab ABS, fa AS) BS {
 	return MonadChain(fab, F.Bind1st(MonadMap[AS, BS, A, B], fa))
 }
@@ -350,3 +396,52 @@ func Flap[FAB ~func(A) B, GFAB ~[]FAB, GB ~[]B, A, B any](a A) func(GFAB) GB {
 func Prepend[ENDO ~func(AS) AS, AS []A, A any](head A) ENDO {
 	return array.Prepend[ENDO](head)
 }
+
+// Reverse returns a new array with the elements in reverse order
+func Reverse[GA ~[]A, A any](as GA) GA {
+	l := len(as)
+	res := make(GA, l)
+	for i, a := range as {
+		res[l-1-i] = a
+	}
+	return res
+}
+
+// Rotate returns a new array with the elements cyclically shifted by n positions to the left
+func Rotate[GA ~[]A, A any](n int) func(GA) GA {
+	return func(as GA) GA {
+		l := len(as)
+		if l == 0 {
+			return Empty[GA]()
+		}
+		offset := ((n % l) + l) % l
+		res := make(GA, 0, l)
+		res = append(res, as[offset:]...)
+		return append(res, as[:offset]...)
+	}
+}
+
+// SplitAt splits an array into the first n elements and the rest, n is clamped to the bounds of the array
+func SplitAt[GA ~[]A, A any](n int) func(GA) tuple.Tuple2[GA, GA] {
+	return func(as GA) tuple.Tuple2[GA, GA] {
+		idx := n
+		if idx < 0 {
+			idx = 0
+		} else if l := len(as); idx > l {
+			idx = l
+		}
+		return tuple.MakeTuple2(Copy(as[:idx]), Copy(as[idx:]))
+	}
+}
+
+// Span splits an array into the longest prefix of elements that satisfy the predicate and the rest
+func Span[GA ~[]A, A any](pred func(A) bool) func(GA) tuple.Tuple2[GA, GA] {
+	return func(as GA) tuple.Tuple2[GA, GA] {
+		for i, a := range as {
+			if !pred(a) {
+				return SplitAt[GA](i)(as)
+			}
+		}
+		return tuple.MakeTuple2(Copy(as), Empty[GA]())
+	}
+}
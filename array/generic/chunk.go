@@ -0,0 +1,62 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	T "github.com/IBM/fp-go/tuple"
+)
+
+// Chunk splits an array into consecutive chunks of the given size, the last chunk may be shorter
+func Chunk[GGA ~[]GA, GA ~[]A, A any](size int) func(GA) GGA {
+	return func(as GA) GGA {
+		if size <= 0 {
+			return Empty[GGA]()
+		}
+		count := len(as)
+		result := make(GGA, 0, (count+size-1)/size)
+		for i := 0; i < count; i += size {
+			end := i + size
+			if end > count {
+				end = count
+			}
+			result = append(result, Copy(as[i:end]))
+		}
+		return result
+	}
+}
+
+// Windows returns all sliding windows of the given size, windows at the end that would be shorter are omitted
+func Windows[GGA ~[]GA, GA ~[]A, A any](size int) func(GA) GGA {
+	return func(as GA) GGA {
+		if size <= 0 || size > len(as) {
+			return Empty[GGA]()
+		}
+		count := len(as) - size + 1
+		result := make(GGA, count)
+		for i := 0; i < count; i++ {
+			result[i] = Copy(as[i : i+size])
+		}
+		return result
+	}
+}
+
+// Pairwise returns the pairs of adjacent elements, arrays with less than two elements result in an empty array
+func Pairwise[GA ~[]A, GT ~[]T.Tuple2[A, A], A any](as GA) GT {
+	if len(as) < 2 {
+		return Empty[GT]()
+	}
+	return ZipWith[GA, GA, GT](as, as[1:], T.MakeTuple2[A, A])
+}
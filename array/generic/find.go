@@ -95,3 +95,29 @@ func FindLastMapWithIndex[AS ~[]A, PRED ~func(int, A) O.Option[B], A, B any](pre
 func FindLastMap[AS ~[]A, PRED ~func(A) O.Option[B], A, B any](pred PRED) func(AS) O.Option[B] {
 	return FindLastMapWithIndex[AS](F.Ignore1of2[int](pred))
 }
+
+// FindIndex finds the index of the first element which satisfies a predicate function
+func FindIndex[AS ~[]A, PRED ~func(A) bool, A any](pred PRED) func(AS) O.Option[int] {
+	none := O.None[int]()
+	return func(as AS) O.Option[int] {
+		for i, a := range as {
+			if pred(a) {
+				return O.Some(i)
+			}
+		}
+		return none
+	}
+}
+
+// FindLastIndex finds the index of the last element which satisfies a predicate function
+func FindLastIndex[AS ~[]A, PRED ~func(A) bool, A any](pred PRED) func(AS) O.Option[int] {
+	none := O.None[int]()
+	return func(as AS) O.Option[int] {
+		for i := len(as) - 1; i >= 0; i-- {
+			if pred(as[i]) {
+				return O.Some(i)
+			}
+		}
+		return none
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+// GroupByReduce folds the elements of an array per key, starting each group with a fresh initial value
+func GroupByReduce[GA ~[]A, A any, K comparable, V any](key func(A) K, reduce func(V, A) V, initial func() V) func(GA) map[K]V {
+	return func(as GA) map[K]V {
+		result := make(map[K]V)
+		for _, a := range as {
+			k := key(a)
+			current, ok := result[k]
+			if !ok {
+				current = initial()
+			}
+			result[k] = reduce(current, a)
+		}
+		return result
+	}
+}
+
+// GroupBy groups the elements of an array by a key, preserving the order of the elements within each group
+func GroupBy[GA ~[]A, A any, K comparable](key func(A) K) func(GA) map[K]GA {
+	return GroupByReduce[GA](key, Append[GA, A], Empty[GA])
+}
@@ -19,6 +19,7 @@ import (
 	"sort"
 
 	F "github.com/IBM/fp-go/function"
+	OPT "github.com/IBM/fp-go/option"
 	O "github.com/IBM/fp-go/ord"
 )
 
@@ -39,7 +40,7 @@ func SortByKey[GA ~[]T, K, T any](ord O.Ord[K], f func(T) K) func(ma GA) GA {
 		// copy
 		cpy := make(GA, l)
 		copy(cpy, ma)
-		sort.Slice(cpy, func(i, j int) bool {
+		sort.SliceStable(cpy, func(i, j int) bool {
 			return ord.Compare(f(cpy[i]), f(cpy[j])) < 0
 		})
 		return cpy
@@ -54,3 +55,31 @@ func SortBy[GA ~[]T, GO ~[]O.Ord[T], T any](ord GO) func(ma GA) GA {
 		Sort[GA, T],
 	)
 }
+
+// reduceOption combines the elements of a non empty array using the given function
+func reduceOption[GA ~[]A, A any](f func(A, A) A) func(GA) OPT.Option[A] {
+	return Match(OPT.None[A], func(as GA) OPT.Option[A] {
+		return OPT.Some(MonadReduce(as[1:], f, as[0]))
+	})
+}
+
+// MaxOf returns the maximum of the array given the provided ordering or `None` for an empty array
+func MaxOf[GA ~[]A, A any](ord O.Ord[A]) func(GA) OPT.Option[A] {
+	return reduceOption[GA](O.Max(ord))
+}
+
+// MinOf returns the minimum of the array given the provided ordering or `None` for an empty array
+func MinOf[GA ~[]A, A any](ord O.Ord[A]) func(GA) OPT.Option[A] {
+	return reduceOption[GA](O.Min(ord))
+}
+
+// Median returns the middle element of the sorted array or `None` for an empty array. For an array of even
+// length the lower of the two middle elements is returned
+func Median[GA ~[]A, A any](ord O.Ord[A]) func(GA) OPT.Option[A] {
+	return F.Flow2(
+		Sort[GA](ord),
+		Match(OPT.None[A], func(as GA) OPT.Option[A] {
+			return OPT.Some(as[(len(as)-1)/2])
+		}),
+	)
+}
@@ -50,3 +50,42 @@ func Unzip[AS ~[]A, BS ~[]B, CS ~[]T.Tuple2[A, B], A, B any](cs CS) T.Tuple2[AS,
 	}
 	return T.MakeTuple2(as, bs)
 }
+
+// Transpose swaps the rows and the columns of a matrix. If the rows have different lengths, the result is truncated
+// to the length of the shortest row
+func Transpose[GGA ~[]GA, GA ~[]A, A any](m GGA) GGA {
+	if len(m) == 0 {
+		return Empty[GGA]()
+	}
+	cols := len(m[0])
+	for _, row := range m[1:] {
+		cols = N.Min(cols, len(row))
+	}
+	res := make(GGA, cols)
+	for j := range res {
+		col := make(GA, len(m))
+		for i, row := range m {
+			col[i] = row[j]
+		}
+		res[j] = col
+	}
+	return res
+}
+
+// Zip3 takes three arrays and returns an array of corresponding triples. If one input array is short, excess elements of the
+// longer arrays are discarded
+func Zip3[AS ~[]A, BS ~[]B, CS ~[]C, TS ~[]T.Tuple3[A, B, C], A, B, C any](fb BS, fc CS) func(AS) TS {
+	return func(fa AS) TS {
+		l := N.Min(len(fa), N.Min(len(fb), len(fc)))
+		res := make(TS, l)
+		for i := l - 1; i >= 0; i-- {
+			res[i] = T.MakeTuple3(fa[i], fb[i], fc[i])
+		}
+		return res
+	}
+}
+
+// ZipWithIndex returns an array of pairs of the index and the element at that index
+func ZipWithIndex[AS ~[]A, TS ~[]T.Tuple2[int, A], A any](fa AS) TS {
+	return MonadMapWithIndex[AS, TS](fa, T.MakeTuple2[int, A])
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	G "github.com/IBM/fp-go/array/generic"
+)
+
+// GroupBy groups the elements of an array by a key, preserving the order of the elements within each group
+func GroupBy[A any, K comparable](key func(A) K) func([]A) map[K][]A {
+	return G.GroupBy[[]A](key)
+}
+
+// GroupByReduce folds the elements of an array per key. The fold of each group starts with the value returned by initial
+func GroupByReduce[A any, K comparable, V any](key func(A) K, reduce func(V, A) V, initial func() V) func([]A) map[K]V {
+	return G.GroupByReduce[[]A](key, reduce, initial)
+}
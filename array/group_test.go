@@ -0,0 +1,60 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type person struct {
+	name string
+	dept string
+}
+
+var people = From(
+	person{"Alice", "Engineering"},
+	person{"Bob", "Sales"},
+	person{"Carol", "Engineering"},
+	person{"Dave", "Sales"},
+	person{"Eve", "Engineering"},
+)
+
+func personDept(p person) string {
+	return p.dept
+}
+
+func TestGroupBy(t *testing.T) {
+	groups := GroupBy(personDept)(people)
+
+	assert.Equal(t, map[string][]person{
+		"Engineering": From(person{"Alice", "Engineering"}, person{"Carol", "Engineering"}, person{"Eve", "Engineering"}),
+		"Sales":       From(person{"Bob", "Sales"}, person{"Dave", "Sales"}),
+	}, groups)
+
+	assert.Empty(t, GroupBy(personDept)(Empty[person]()))
+}
+
+func TestGroupByReduce(t *testing.T) {
+	count := GroupByReduce(personDept, func(n int, _ person) int {
+		return n + 1
+	}, func() int {
+		return 0
+	})
+
+	assert.Equal(t, map[string]int{"Engineering": 3, "Sales": 2}, count(people))
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nonempty
+
+import (
+	"testing"
+
+	N "github.com/IBM/fp-go/number"
+	S "github.com/IBM/fp-go/semigroup"
+	STR "github.com/IBM/fp-go/string"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFold(t *testing.T) {
+	// no initial value required, the first element is the start
+	assert.Equal(t, 6, Fold(N.SemigroupSum[int]())(From(1, 2, 3)))
+	assert.Equal(t, 1, Fold(N.SemigroupSum[int]())(Of(1)))
+	// order is preserved
+	assert.Equal(t, "a, b, c", Fold(S.Intercalate(", ")(STR.Semigroup()))(From("a", "b", "c")))
+	// semigroups without identity
+	assert.Equal(t, "a", Fold(S.First[string]())(From("a", "b", "c")))
+	assert.Equal(t, "c", Fold(S.Last[string]())(From("a", "b", "c")))
+}
+
+func TestFoldMap(t *testing.T) {
+	assert.Equal(t, 3, FoldMap[string](N.SemigroupSum[int]())(STR.Size)(From("a", "bc")))
+}
@@ -17,6 +17,7 @@ package array
 
 import (
 	G "github.com/IBM/fp-go/array/generic"
+	OPT "github.com/IBM/fp-go/option"
 	O "github.com/IBM/fp-go/ord"
 )
 
@@ -34,3 +35,19 @@ func SortByKey[K, T any](ord O.Ord[K], f func(T) K) func(ma []T) []T {
 func SortBy[T any](ord []O.Ord[T]) func(ma []T) []T {
 	return G.SortBy[[]T, []O.Ord[T]](ord)
 }
+
+// MaxOf returns the maximum of the array given the provided ordering or `None` for an empty array
+func MaxOf[A any](ord O.Ord[A]) func([]A) OPT.Option[A] {
+	return G.MaxOf[[]A](ord)
+}
+
+// MinOf returns the minimum of the array given the provided ordering or `None` for an empty array
+func MinOf[A any](ord O.Ord[A]) func([]A) OPT.Option[A] {
+	return G.MinOf[[]A](ord)
+}
+
+// Median returns the middle element of the sorted array or `None` for an empty array. For an array of even
+// length the lower of the two middle elements is returned
+func Median[A any](ord O.Ord[A]) func([]A) OPT.Option[A] {
+	return G.Median[[]A](ord)
+}
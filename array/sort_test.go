@@ -18,6 +18,7 @@ package array
 import (
 	"testing"
 
+	OPT "github.com/IBM/fp-go/option"
 	O "github.com/IBM/fp-go/ord"
 	"github.com/stretchr/testify/assert"
 )
@@ -34,3 +35,28 @@ func TestSort(t *testing.T) {
 	assert.Equal(t, []int{2, 1, 3}, input)
 
 }
+
+func TestMaxMinOf(t *testing.T) {
+
+	ordInt := O.FromStrictCompare[int]()
+
+	assert.Equal(t, OPT.None[int](), MaxOf(ordInt)(Empty[int]()))
+	assert.Equal(t, OPT.None[int](), MinOf(ordInt)(Empty[int]()))
+
+	assert.Equal(t, OPT.Of(1), MaxOf(ordInt)(From(1)))
+	assert.Equal(t, OPT.Of(1), MinOf(ordInt)(From(1)))
+
+	assert.Equal(t, OPT.Of(3), MaxOf(ordInt)(From(2, 3, 1, 2)))
+	assert.Equal(t, OPT.Of(1), MinOf(ordInt)(From(2, 3, 1, 2)))
+}
+
+func TestMedian(t *testing.T) {
+
+	ordInt := O.FromStrictCompare[int]()
+
+	assert.Equal(t, OPT.None[int](), Median(ordInt)(Empty[int]()))
+	assert.Equal(t, OPT.Of(1), Median(ordInt)(From(1)))
+	assert.Equal(t, OPT.Of(2), Median(ordInt)(From(3, 1, 2)))
+	// lower middle for even lengths
+	assert.Equal(t, OPT.Of(2), Median(ordInt)(From(4, 1, 3, 2)))
+}
@@ -36,3 +36,20 @@ func Zip[A, B any](fb []B) func([]A) []T.Tuple2[A, B] {
 func Unzip[A, B any](cs []T.Tuple2[A, B]) T.Tuple2[[]A, []B] {
 	return G.Unzip[[]A, []B, []T.Tuple2[A, B]](cs)
 }
+
+// Transpose swaps the rows and the columns of a matrix. If the rows have different lengths, excess elements
+// of the longer rows are discarded, like for [Zip]
+func Transpose[A any](m [][]A) [][]A {
+	return G.Transpose(m)
+}
+
+// Zip3 takes three arrays and returns an array of corresponding triples. If one input array is short, excess elements of the
+// longer arrays are discarded
+func Zip3[A, B, C any](fb []B, fc []C) func([]A) []T.Tuple3[A, B, C] {
+	return G.Zip3[[]A, []B, []C, []T.Tuple3[A, B, C]](fb, fc)
+}
+
+// ZipWithIndex returns an array of pairs of the index and the element at that index
+func ZipWithIndex[A any](fa []A) []T.Tuple2[int, A] {
+	return G.ZipWithIndex[[]A, []T.Tuple2[int, A]](fa)
+}
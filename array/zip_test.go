@@ -54,3 +54,22 @@ func TestUnzip(t *testing.T) {
 	assert.Equal(t, right, unzipped.F1)
 	assert.Equal(t, left, unzipped.F2)
 }
+
+func TestTranspose(t *testing.T) {
+	assert.Equal(t, [][]int{{1, 4}, {2, 5}, {3, 6}}, Transpose([][]int{{1, 2, 3}, {4, 5, 6}}))
+	// ragged input is truncated to the shortest row
+	assert.Equal(t, [][]int{{1, 4, 6}, {2, 5, 7}}, Transpose([][]int{{1, 2, 3}, {4, 5}, {6, 7, 8}}))
+	assert.Equal(t, [][]int{}, Transpose([][]int{}))
+}
+
+func TestZip3(t *testing.T) {
+	res := Zip3[int](From("a", "b", "c"), From(true, false))(From(1, 2, 3, 4))
+
+	assert.Equal(t, From(T.MakeTuple3(1, "a", true), T.MakeTuple3(2, "b", false)), res)
+	assert.Equal(t, []T.Tuple3[int, string, bool]{}, Zip3[int](From("a"), From(true))(Empty[int]()))
+}
+
+func TestZipWithIndex(t *testing.T) {
+	assert.Equal(t, From(T.MakeTuple2(0, "a"), T.MakeTuple2(1, "b")), ZipWithIndex(From("a", "b")))
+	assert.Equal(t, []T.Tuple2[int, string]{}, ZipWithIndex(Empty[string]()))
+}
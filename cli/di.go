@@ -49,8 +49,13 @@ func generateMakeProvider(f *os.File, i int) {
 	}
 	fmt.Fprintf(f, ") IOE.IOEither[error, R],\n")
 	fmt.Fprintf(f, ") DIE.Provider {\n")
-	fmt.Fprint(f, "  return DIE.MakeProvider(\n")
+	fmt.Fprint(f, "  return DIE.MakeProviderWithDependencies(\n")
 	fmt.Fprint(f, "    token,\n")
+	fmt.Fprint(f, "    A.From[DIE.Dependency](\n")
+	for j := 0; j < i; j++ {
+		fmt.Fprintf(f, "      d%d,\n", j+1)
+	}
+	fmt.Fprint(f, "    ),\n")
 	fmt.Fprintf(f, "    MakeProviderFactory%d(\n", i)
 	for j := 0; j < i; j++ {
 		fmt.Fprintf(f, "      d%d,\n", j+1)
@@ -60,6 +65,55 @@ func generateMakeProvider(f *os.File, i int) {
 	fmt.Fprintf(f, "}\n")
 }
 
+func generateMakeProviderReader(f *os.File, i int) {
+	// non generic version
+	fmt.Fprintf(f, "\n// MakeProviderReader%d creates a [DIE.Provider] for an [InjectionToken] from a function with %d dependencies that returns a [CRIOE.ReaderIOEither].\n", i, i)
+	fmt.Fprintf(f, "// The [context.Context] passed to the reader is resolved via [InjContext]\n")
+	fmt.Fprintf(f, "func MakeProviderReader%d[", i)
+	for j := 0; j < i; j++ {
+		if j > 0 {
+			fmt.Fprintf(f, ", ")
+		}
+		fmt.Fprintf(f, "T%d", j+1)
+	}
+	fmt.Fprintf(f, " any, R any](\n")
+	fmt.Fprintf(f, "  token InjectionToken[R],\n")
+	for j := 0; j < i; j++ {
+		fmt.Fprintf(f, "  d%d Dependency[T%d],\n", j+1, j+1)
+	}
+	fmt.Fprintf(f, "  f func(")
+	for j := 0; j < i; j++ {
+		if j > 0 {
+			fmt.Fprintf(f, ", ")
+		}
+		fmt.Fprintf(f, "T%d", j+1)
+	}
+	fmt.Fprintf(f, ") CRIOE.ReaderIOEither[R],\n")
+	fmt.Fprintf(f, ") DIE.Provider {\n")
+	fmt.Fprintf(f, "  return MakeProvider%d(\n", i+1)
+	fmt.Fprint(f, "    token,\n")
+	fmt.Fprint(f, "    InjContext.Identity(),\n")
+	for j := 0; j < i; j++ {
+		fmt.Fprintf(f, "    d%d,\n", j+1)
+	}
+	fmt.Fprint(f, "    func(ctx context.Context")
+	for j := 0; j < i; j++ {
+		fmt.Fprintf(f, ", t%d T%d", j+1, j+1)
+	}
+	fmt.Fprint(f, ") IOE.IOEither[error, R] {\n")
+	fmt.Fprint(f, "      return f(")
+	for j := 0; j < i; j++ {
+		if j > 0 {
+			fmt.Fprintf(f, ", ")
+		}
+		fmt.Fprintf(f, "t%d", j+1)
+	}
+	fmt.Fprint(f, ")(ctx)\n")
+	fmt.Fprint(f, "    },\n")
+	fmt.Fprint(f, "  )\n")
+	fmt.Fprintf(f, "}\n")
+}
+
 func generateMakeTokenWithDefault(f *os.File, i int) {
 	// non generic version
 	fmt.Fprintf(f, "\n// MakeTokenWithDefault%d creates an [InjectionToken] with a default implementation with %d dependencies\n", i, i)
@@ -195,6 +249,9 @@ func generateDIHelpers(filename string, count int) error {
 
 	fmt.Fprint(f, `
 import (
+	"context"
+
+	CRIOE "github.com/IBM/fp-go/context/readerioeither"
 	E "github.com/IBM/fp-go/either"
 	IOE "github.com/IBM/fp-go/ioeither"
 	T "github.com/IBM/fp-go/tuple"
@@ -208,6 +265,10 @@ import (
 		generateMakeProviderFactory(f, i)
 		generateMakeTokenWithDefault(f, i)
 		generateMakeProvider(f, i)
+		// the reader variant requires an additional dependency for the context
+		if i < count {
+			generateMakeProviderReader(f, i)
+		}
 	}
 
 	return nil
@@ -0,0 +1,60 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"context"
+	"errors"
+
+	E "github.com/IBM/fp-go/either"
+)
+
+// errEmptyRace is the failure of a [Race] without computations
+var errEmptyRace = errors.New("race on an empty array")
+
+// Race runs all computations concurrently and returns the result of the first one to complete, no matter if
+// that result is a success or a failure. The remaining computations are cancelled via their context. Race on an empty
+// array fails immediately.
+func Race[
+	GRA ~func(context.Context) GIOA,
+	GIOA ~func() E.Either[error, A],
+	GRAS ~[]GRA,
+	A any](as GRAS) GRA {
+	return func(ctx context.Context) GIOA {
+		return func() E.Either[error, A] {
+			// without computations there would be nothing to wait for
+			if len(as) == 0 {
+				return E.Left[A](errEmptyRace)
+			}
+			// the losers are cancelled as soon as we have a result
+			cancelCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			// buffered so the losers do not block after the result has been consumed
+			c := make(chan E.Either[error, A], len(as))
+			for _, a := range as {
+				go func(a GRA) {
+					c <- a(cancelCtx)()
+				}(a)
+			}
+			select {
+			case res := <-c:
+				return res
+			case <-ctx.Done():
+				return E.Left[A](context.Cause(ctx))
+			}
+		}
+	}
+}
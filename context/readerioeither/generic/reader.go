@@ -551,6 +551,37 @@ func Delay[
 				// whatever comes first
 				select {
 				case <-timeoutCtx.Done():
+					if ctx.Err() != nil {
+						return E.Left[A](context.Cause(ctx))
+					}
+					return ma(ctx)()
+				case <-ctx.Done():
+					return E.Left[A](context.Cause(ctx))
+				}
+			})
+		}
+	}
+}
+
+// After creates an operation that passes in the value after the given [time.Time]. The wait is aborted
+// with an error if the context is cancelled before
+func After[
+	GRA ~func(context.Context) GIOA,
+	GIOA ~func() E.Either[error, A],
+
+	A any](timestamp time.Time) func(ma GRA) GRA {
+	return func(ma GRA) GRA {
+		return func(ctx context.Context) GIOA {
+			return IOE.MakeIO(func() E.Either[error, A] {
+				// manage the deadline
+				deadlineCtx, cancelDeadline := context.WithDeadline(ctx, timestamp)
+				defer cancelDeadline()
+				// whatever comes first
+				select {
+				case <-deadlineCtx.Done():
+					if ctx.Err() != nil {
+						return E.Left[A](context.Cause(ctx))
+					}
 					return ma(ctx)()
 				case <-ctx.Done():
 					return E.Left[A](context.Cause(ctx))
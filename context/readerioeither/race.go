@@ -0,0 +1,27 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readerioeither
+
+import (
+	G "github.com/IBM/fp-go/context/readerioeither/generic"
+)
+
+// Race runs all computations concurrently and returns the result of the first one to complete, no matter if
+// that result is a success or a failure. The remaining computations are cancelled via their context. Race on an empty
+// array fails immediately.
+func Race[A any](as []ReaderIOEither[A]) ReaderIOEither[A] {
+	return G.Race[ReaderIOEither[A]](as)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readerioeither
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	E "github.com/IBM/fp-go/either"
+	F "github.com/IBM/fp-go/function"
+	IOE "github.com/IBM/fp-go/ioeither"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRace(t *testing.T) {
+	cancelled := make(chan error, 1)
+
+	// the slow branch reports if it has been cancelled
+	slow := ReaderIOEither[string](func(ctx context.Context) IOE.IOEither[error, string] {
+		return func() E.Either[error, string] {
+			select {
+			case <-time.After(time.Second):
+				cancelled <- nil
+				return E.Of[error]("slow")
+			case <-ctx.Done():
+				cancelled <- ctx.Err()
+				return E.Left[string](ctx.Err())
+			}
+		}
+	})
+	fast := F.Pipe1(
+		Of("fast"),
+		Delay[string](10*time.Millisecond),
+	)
+
+	assert.Equal(t, E.Of[error]("fast"), Race([]ReaderIOEither[string]{slow, fast})(context.Background())())
+	// the loser has been cancelled
+	assert.Equal(t, context.Canceled, <-cancelled)
+}
+
+func TestRaceCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// never completes on its own
+	never := ReaderIOEither[string](func(_ context.Context) IOE.IOEither[error, string] {
+		return func() E.Either[error, string] {
+			<-time.After(time.Second)
+			return E.Of[error]("never")
+		}
+	})
+
+	res := Race([]ReaderIOEither[string]{never})(ctx)()
+	assert.Equal(t, E.Left[string](context.Canceled), res)
+}
+
+func TestRaceEmpty(t *testing.T) {
+	res := Race([]ReaderIOEither[string]{})(context.Background())()
+	assert.True(t, E.IsLeft(res))
+}
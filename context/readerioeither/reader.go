@@ -160,6 +160,12 @@ func Delay[A any](delay time.Duration) func(ma ReaderIOEither[A]) ReaderIOEither
 	return G.Delay[ReaderIOEither[A]](delay)
 }
 
+// After creates an operation that passes in the value after the given [time.Time]. The wait is aborted
+// with an error if the context is cancelled before
+func After[A any](timestamp time.Time) func(ma ReaderIOEither[A]) ReaderIOEither[A] {
+	return G.After[ReaderIOEither[A]](timestamp)
+}
+
 // Timer will return the current time after an initial delay
 func Timer(delay time.Duration) ReaderIOEither[time.Time] {
 	return G.Timer[ReaderIOEither[time.Time]](delay)
@@ -284,3 +284,60 @@ func TestWithResourceErrorInRelease(t *testing.T) {
 	assert.Equal(t, 0, countRelease)
 	assert.Equal(t, E.Left[int](err), res)
 }
+
+func TestDelayCanceled(t *testing.T) {
+	err := fmt.Errorf("TestDelayCanceled")
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(err)
+
+	var count int
+
+	delayed := F.Pipe1(
+		FromLazy(func() int {
+			count++
+			return count
+		}),
+		Delay[int](time.Second),
+	)
+
+	assert.Equal(t, E.Left[int](err), delayed(ctx)())
+	assert.Equal(t, 0, count)
+}
+
+func TestAfter(t *testing.T) {
+	timestamp := time.Now().Add(100 * time.Millisecond)
+
+	res := F.Pipe1(
+		Of(1),
+		After[int](timestamp),
+	)(context.Background())()
+
+	assert.Equal(t, E.Of[error](1), res)
+	assert.False(t, time.Now().Before(timestamp))
+}
+
+func TestAfterCanceled(t *testing.T) {
+	err := fmt.Errorf("TestAfterCanceled")
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+
+	var count int
+
+	delayed := F.Pipe1(
+		FromLazy(func() int {
+			count++
+			return count
+		}),
+		After[int](time.Now().Add(time.Hour)),
+	)
+
+	// cancel while waiting
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel(err)
+	}()
+
+	assert.Equal(t, E.Left[int](err), delayed(ctx)())
+	assert.Equal(t, 0, count)
+}
@@ -16,6 +16,9 @@
 package di
 
 import (
+	"errors"
+	"fmt"
+
 	DIE "github.com/IBM/fp-go/di/erasure"
 	F "github.com/IBM/fp-go/function"
 	IO "github.com/IBM/fp-go/io"
@@ -36,3 +39,30 @@ var RunMain = F.Flow3(
 	Main,
 	IOE.Fold(IO.Of[error], F.Constant1[any](IO.Of[error](nil))),
 )
+
+// validateProvider resolves the [DIE.Dependency] implemented by a [DIE.Provider] and returns the error annotated with the provider, if any
+func validateProvider(inj DIE.InjectableFactory) func(DIE.Provider) IO.IO[error] {
+	return func(p DIE.Provider) IO.IO[error] {
+		return F.Pipe2(
+			p.Provides(),
+			inj,
+			IOE.Fold(func(err error) IO.IO[error] {
+				return IO.Of(fmt.Errorf("%s: %w", p, err))
+			}, F.Constant1[any](IO.Of[error](nil))),
+		)
+	}
+}
+
+// ValidateGraph resolves the dependencies of all [DIE.Provider]s, not just the ones required by [InjMain]. It returns
+// the joined errors of all providers that cannot be resolved or nil if all of them could be resolved.
+func ValidateGraph(providers []DIE.Provider) IO.IO[error] {
+	return IO.Defer(func() IO.IO[error] {
+		return F.Pipe2(
+			providers,
+			IO.TraverseArray(validateProvider(DIE.MakeInjector(providers))),
+			IO.Map(func(errs []error) error {
+				return errors.Join(errs...)
+			}),
+		)
+	})
+}
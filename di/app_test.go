@@ -0,0 +1,51 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package di
+
+import (
+	"fmt"
+	"testing"
+
+	A "github.com/IBM/fp-go/array"
+	IOE "github.com/IBM/fp-go/ioeither"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGraph(t *testing.T) {
+	injConfig := MakeToken[string]("Config")
+	injDB := MakeToken[string]("DB")
+	injService := MakeToken[string]("Service")
+
+	withDependency := func(value string) IOE.IOEither[error, string] {
+		return IOE.Of[error](fmt.Sprintf("Dependency: %s", value))
+	}
+
+	// the config is missing
+	pDB := MakeProvider1(injDB, injConfig.Identity(), withDependency)
+	pService := ConstProvider(injService, "Service")
+
+	err := ValidateGraph(A.From(pDB, pService))()
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "[Config]")
+	assert.ErrorContains(t, err, "[DB]")
+	assert.NotContains(t, err.Error(), "[Service]")
+
+	// all dependencies are available
+	pConfig := ConstProvider(injConfig, "Config")
+
+	assert.NoError(t, ValidateGraph(A.From(pDB, pService, pConfig))())
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package di
+
+import (
+	"context"
+
+	CRIOE "github.com/IBM/fp-go/context/readerioeither"
+	DIE "github.com/IBM/fp-go/di/erasure"
+)
+
+// InjContext is the [InjectionToken] for the [context.Context] passed to the readers of the [MakeProviderReader0] family
+var InjContext = MakeToken[context.Context]("CONTEXT")
+
+// ContextProvider creates a [DIE.Provider] for [InjContext]
+func ContextProvider(ctx context.Context) DIE.Provider {
+	return ConstProvider(InjContext, ctx)
+}
+
+// MakeProviderReader0 creates a [DIE.Provider] for an [InjectionToken] from a [CRIOE.ReaderIOEither] without dependencies.
+// The [context.Context] passed to the reader is resolved via [InjContext]
+func MakeProviderReader0[R any](
+	token InjectionToken[R],
+	fct CRIOE.ReaderIOEither[R],
+) DIE.Provider {
+	return MakeProvider1(
+		token,
+		InjContext.Identity(),
+		fct,
+	)
+}
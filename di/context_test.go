@@ -0,0 +1,78 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package di
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	A "github.com/IBM/fp-go/array"
+	CRIOE "github.com/IBM/fp-go/context/readerioeither"
+	DIE "github.com/IBM/fp-go/di/erasure"
+	E "github.com/IBM/fp-go/either"
+	F "github.com/IBM/fp-go/function"
+	IOE "github.com/IBM/fp-go/ioeither"
+	"github.com/stretchr/testify/assert"
+)
+
+// waitFor produces the value after the delay unless the context is cancelled first
+func waitFor(delay time.Duration, value string) CRIOE.ReaderIOEither[string] {
+	return func(ctx context.Context) IOE.IOEither[error, string] {
+		return func() E.Either[error, string] {
+			select {
+			case <-time.After(delay):
+				return E.Of[error](value)
+			case <-ctx.Done():
+				return E.Left[string](context.Cause(ctx))
+			}
+		}
+	}
+}
+
+func TestMakeProviderReader(t *testing.T) {
+
+	p1 := ConstProvider(INJ_KEY1, "Carsten")
+	p2 := MakeProviderReader1(INJ_KEY2, INJ_KEY1.Identity(), func(name string) CRIOE.ReaderIOEither[string] {
+		return waitFor(time.Millisecond, fmt.Sprintf("Hello %s", name))
+	})
+
+	res := F.Pipe2(
+		A.From(ContextProvider(context.Background()), p1, p2),
+		DIE.MakeInjector,
+		Resolve(INJ_KEY2),
+	)
+
+	assert.Equal(t, E.Of[error]("Hello Carsten"), res())
+}
+
+func TestMakeProviderReaderDeadline(t *testing.T) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	p1 := MakeProviderReader0(INJ_KEY1, waitFor(time.Minute, "Carsten"))
+
+	res := F.Pipe2(
+		A.From(ContextProvider(ctx), p1),
+		DIE.MakeInjector,
+		Resolve(INJ_KEY1),
+	)
+
+	err := E.ToError(res())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
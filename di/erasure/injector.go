@@ -16,6 +16,8 @@
 package erasure
 
 import (
+	"fmt"
+
 	A "github.com/IBM/fp-go/array"
 	"github.com/IBM/fp-go/errors"
 	F "github.com/IBM/fp-go/function"
@@ -25,6 +27,7 @@ import (
 	L "github.com/IBM/fp-go/lazy"
 	O "github.com/IBM/fp-go/option"
 	R "github.com/IBM/fp-go/record"
+	S "github.com/IBM/fp-go/string"
 	T "github.com/IBM/fp-go/tuple"
 
 	"sync"
@@ -113,11 +116,35 @@ func itemProviderFactory(fcts []ProviderFactory) ProviderFactory {
 	}
 }
 
-// MakeInjector creates an [InjectableFactory] based on a set of [Provider]s
-//
-// The resulting [InjectableFactory] can then be used to retrieve service instances given their [Dependency]. The implementation
-// makes sure to transitively resolve the required dependencies.
-func MakeInjector(providers []Provider) InjectableFactory {
+// isCyclicDependency tests if a dependency is already part of the resolution path
+func isCyclicDependency(dep Dependency) func([]Dependency) bool {
+	key := dep.Id()
+	return A.Any(func(d Dependency) bool {
+		return d.Id() == key
+	})
+}
+
+// cyclicDependencyError returns an error that lists the tokens forming the cycle
+func cyclicDependencyError(path []Dependency, dep Dependency) error {
+	return fmt.Errorf("cyclic dependency [%s]", F.Pipe3(
+		path,
+		A.Push(dep),
+		A.Map(Dependency.String),
+		A.Intercalate(S.Monoid)(" -> "),
+	))
+}
+
+// fromParent returns a [ProviderFactory] that delegates the resolution of a dependency to a parent [InjectableFactory]
+func fromParent(parent InjectableFactory) func(Dependency) func() ProviderFactory {
+	return func(dep Dependency) func() ProviderFactory {
+		return func() ProviderFactory {
+			return F.Constant1[InjectableFactory](parent(dep))
+		}
+	}
+}
+
+// makeInjector creates an [InjectableFactory] based on a set of [Provider]s and a fallback for dependencies without a provider
+func makeInjector(providers []Provider, handleMissingProvider func(Dependency) func() ProviderFactory) InjectableFactory {
 
 	type Result = IOE.IOEither[error, any]
 	type LazyResult = L.Lazy[Result]
@@ -129,42 +156,69 @@ func MakeInjector(providers []Provider) InjectableFactory {
 	// provide a mapping for all providers
 	factoryByID := assembleProviders(providers)
 
-	// the actual factory, we need lazy initialization
-	var injFct InjectableFactory
+	// the actual factory for a resolution path, we need lazy initialization
+	var makeInjFct func(path []Dependency) InjectableFactory
 
 	// lazy initialization, so we can cross reference it
-	injFct = func(token Dependency) Result {
-
-		key := token.Id()
-
-		// according to https://github.com/golang/go/issues/44159 this
-		// is the best way to use the sync map
-		actual, loaded := resolved.Load(key)
-		if !loaded {
-
-			computeResult := L.MakeLazy(func() Result {
-				return F.Pipe5(
-					token,
-					T.Replicate2[Dependency],
-					T.Map2(F.Flow3(
-						Dependency.Id,
-						R.Lookup[ProviderFactory, string],
-						I.Ap[O.Option[ProviderFactory]](factoryByID),
-					), handleMissingProvider),
-					T.Tupled2(O.MonadGetOrElse[ProviderFactory]),
-					IG.Ap[ProviderFactory](injFct),
-					IOE.Memoize[error, any],
-				)
-			})
-
-			actual, _ = resolved.LoadOrStore(key, F.Pipe1(
-				computeResult,
-				L.Memoize[Result],
-			))
+	makeInjFct = func(path []Dependency) InjectableFactory {
+
+		return func(token Dependency) Result {
+
+			// the token is already being resolved on this path
+			if isCyclicDependency(token)(path) {
+				return IOE.Left[any](cyclicDependencyError(path, token))
+			}
+
+			key := token.Id()
+
+			// according to https://github.com/golang/go/issues/44159 this
+			// is the best way to use the sync map
+			actual, loaded := resolved.Load(key)
+			if !loaded {
+
+				computeResult := L.MakeLazy(func() Result {
+					return F.Pipe5(
+						token,
+						T.Replicate2[Dependency],
+						T.Map2(F.Flow3(
+							Dependency.Id,
+							R.Lookup[ProviderFactory, string],
+							I.Ap[O.Option[ProviderFactory]](factoryByID),
+						), handleMissingProvider),
+						T.Tupled2(O.MonadGetOrElse[ProviderFactory]),
+						IG.Ap[ProviderFactory](makeInjFct(A.Push(token)(path))),
+						IOE.Memoize[error, any],
+					)
+				})
+
+				actual, _ = resolved.LoadOrStore(key, F.Pipe1(
+					computeResult,
+					L.Memoize[Result],
+				))
+			}
+
+			return actual.(LazyResult)()
 		}
-
-		return actual.(LazyResult)()
 	}
 
-	return injFct
+	return makeInjFct(A.Empty[Dependency]())
+}
+
+// MakeInjector creates an [InjectableFactory] based on a set of [Provider]s
+//
+// The resulting [InjectableFactory] can then be used to retrieve service instances given their [Dependency]. The implementation
+// makes sure to transitively resolve the required dependencies.
+//
+// Cyclic dependencies are detected while resolving and reported as an error that lists the tokens forming the cycle.
+func MakeInjector(providers []Provider) InjectableFactory {
+	return makeInjector(providers, handleMissingProvider)
+}
+
+// MakeChildInjector creates an [InjectableFactory] that resolves dependencies from a set of overriding [Provider]s first and
+// falls back to the parent [InjectableFactory] for all other dependencies.
+//
+// Instances created by the overrides are singletons within the child, inherited instances are the ones of the parent, so
+// the parent remains unaffected by the overrides. Item providers in the overrides replace all items of the parent.
+func MakeChildInjector(parent InjectableFactory, overrides []Provider) InjectableFactory {
+	return makeInjector(overrides, fromParent(parent))
 }
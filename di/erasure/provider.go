@@ -49,9 +49,17 @@ type (
 		Factory() ProviderFactory
 	}
 
+	// ProviderWithDependencies is implemented by [Provider]s that declare the [Dependency]s consumed by their [ProviderFactory]
+	ProviderWithDependencies interface {
+		Provider
+		// Dependencies returns the [Dependency]s declared by this provider
+		Dependencies() []Dependency
+	}
+
 	provider struct {
-		provides Dependency
-		factory  ProviderFactory
+		provides     Dependency
+		dependencies []Dependency
+		factory      ProviderFactory
 	}
 )
 
@@ -63,12 +71,22 @@ func (p *provider) Factory() ProviderFactory {
 	return p.factory
 }
 
+func (p *provider) Dependencies() []Dependency {
+	return p.dependencies
+}
+
 func (p *provider) String() string {
 	return fmt.Sprintf("Provider for [%s]", p.provides)
 }
 
+// MakeProvider creates a [Provider] that does not declare any [Dependency]s
 func MakeProvider(token Dependency, fct ProviderFactory) Provider {
-	return &provider{token, fct}
+	return MakeProviderWithDependencies(token, A.Empty[Dependency](), fct)
+}
+
+// MakeProviderWithDependencies creates a [Provider] that declares the [Dependency]s consumed by its [ProviderFactory]
+func MakeProviderWithDependencies(token Dependency, deps []Dependency, fct ProviderFactory) Provider {
+	return &provider{token, deps, fct}
 }
 
 func mapFromToken(idx int, token Dependency) map[int]paramIndex {
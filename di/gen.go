@@ -1,11 +1,14 @@
 // Code generated by go generate; DO NOT EDIT.
 // This file was generated by robots at
-// 2024-02-29 16:18:47.9991921 +0100 CET m=+0.012914901
+// 2026-10-16 08:14:11.08314889 +0000 UTC m=+0.001799401
 
 package di
 
 import (
+	"context"
+
 	A "github.com/IBM/fp-go/array"
+	CRIOE "github.com/IBM/fp-go/context/readerioeither"
 	DIE "github.com/IBM/fp-go/di/erasure"
 	E "github.com/IBM/fp-go/either"
 	IOE "github.com/IBM/fp-go/ioeither"
@@ -59,14 +62,34 @@ func MakeProvider1[T1 any, R any](
 	d1 Dependency[T1],
 	f func(T1) IOE.IOEither[error, R],
 ) DIE.Provider {
-	return DIE.MakeProvider(
+	return DIE.MakeProviderWithDependencies(
 		token,
+		A.From[DIE.Dependency](
+			d1,
+		),
 		MakeProviderFactory1(
 			d1,
 			f,
 		))
 }
 
+// MakeProviderReader1 creates a [DIE.Provider] for an [InjectionToken] from a function with 1 dependencies that returns a [CRIOE.ReaderIOEither].
+// The [context.Context] passed to the reader is resolved via [InjContext]
+func MakeProviderReader1[T1 any, R any](
+	token InjectionToken[R],
+	d1 Dependency[T1],
+	f func(T1) CRIOE.ReaderIOEither[R],
+) DIE.Provider {
+	return MakeProvider2(
+		token,
+		InjContext.Identity(),
+		d1,
+		func(ctx context.Context, t1 T1) IOE.IOEither[error, R] {
+			return f(t1)(ctx)
+		},
+	)
+}
+
 // eraseProviderFactory2 creates a function that takes a variadic number of untyped arguments and from a function of 2 strongly typed arguments and 2 dependencies
 func eraseProviderFactory2[T1, T2 any, R any](
 	d1 Dependency[T1],
@@ -123,8 +146,12 @@ func MakeProvider2[T1, T2 any, R any](
 	d2 Dependency[T2],
 	f func(T1, T2) IOE.IOEither[error, R],
 ) DIE.Provider {
-	return DIE.MakeProvider(
+	return DIE.MakeProviderWithDependencies(
 		token,
+		A.From[DIE.Dependency](
+			d1,
+			d2,
+		),
 		MakeProviderFactory2(
 			d1,
 			d2,
@@ -132,6 +159,25 @@ func MakeProvider2[T1, T2 any, R any](
 		))
 }
 
+// MakeProviderReader2 creates a [DIE.Provider] for an [InjectionToken] from a function with 2 dependencies that returns a [CRIOE.ReaderIOEither].
+// The [context.Context] passed to the reader is resolved via [InjContext]
+func MakeProviderReader2[T1, T2 any, R any](
+	token InjectionToken[R],
+	d1 Dependency[T1],
+	d2 Dependency[T2],
+	f func(T1, T2) CRIOE.ReaderIOEither[R],
+) DIE.Provider {
+	return MakeProvider3(
+		token,
+		InjContext.Identity(),
+		d1,
+		d2,
+		func(ctx context.Context, t1 T1, t2 T2) IOE.IOEither[error, R] {
+			return f(t1, t2)(ctx)
+		},
+	)
+}
+
 // eraseProviderFactory3 creates a function that takes a variadic number of untyped arguments and from a function of 3 strongly typed arguments and 3 dependencies
 func eraseProviderFactory3[T1, T2, T3 any, R any](
 	d1 Dependency[T1],
@@ -197,8 +243,13 @@ func MakeProvider3[T1, T2, T3 any, R any](
 	d3 Dependency[T3],
 	f func(T1, T2, T3) IOE.IOEither[error, R],
 ) DIE.Provider {
-	return DIE.MakeProvider(
+	return DIE.MakeProviderWithDependencies(
 		token,
+		A.From[DIE.Dependency](
+			d1,
+			d2,
+			d3,
+		),
 		MakeProviderFactory3(
 			d1,
 			d2,
@@ -207,6 +258,27 @@ func MakeProvider3[T1, T2, T3 any, R any](
 		))
 }
 
+// MakeProviderReader3 creates a [DIE.Provider] for an [InjectionToken] from a function with 3 dependencies that returns a [CRIOE.ReaderIOEither].
+// The [context.Context] passed to the reader is resolved via [InjContext]
+func MakeProviderReader3[T1, T2, T3 any, R any](
+	token InjectionToken[R],
+	d1 Dependency[T1],
+	d2 Dependency[T2],
+	d3 Dependency[T3],
+	f func(T1, T2, T3) CRIOE.ReaderIOEither[R],
+) DIE.Provider {
+	return MakeProvider4(
+		token,
+		InjContext.Identity(),
+		d1,
+		d2,
+		d3,
+		func(ctx context.Context, t1 T1, t2 T2, t3 T3) IOE.IOEither[error, R] {
+			return f(t1, t2, t3)(ctx)
+		},
+	)
+}
+
 // eraseProviderFactory4 creates a function that takes a variadic number of untyped arguments and from a function of 4 strongly typed arguments and 4 dependencies
 func eraseProviderFactory4[T1, T2, T3, T4 any, R any](
 	d1 Dependency[T1],
@@ -281,8 +353,14 @@ func MakeProvider4[T1, T2, T3, T4 any, R any](
 	d4 Dependency[T4],
 	f func(T1, T2, T3, T4) IOE.IOEither[error, R],
 ) DIE.Provider {
-	return DIE.MakeProvider(
+	return DIE.MakeProviderWithDependencies(
 		token,
+		A.From[DIE.Dependency](
+			d1,
+			d2,
+			d3,
+			d4,
+		),
 		MakeProviderFactory4(
 			d1,
 			d2,
@@ -292,6 +370,29 @@ func MakeProvider4[T1, T2, T3, T4 any, R any](
 		))
 }
 
+// MakeProviderReader4 creates a [DIE.Provider] for an [InjectionToken] from a function with 4 dependencies that returns a [CRIOE.ReaderIOEither].
+// The [context.Context] passed to the reader is resolved via [InjContext]
+func MakeProviderReader4[T1, T2, T3, T4 any, R any](
+	token InjectionToken[R],
+	d1 Dependency[T1],
+	d2 Dependency[T2],
+	d3 Dependency[T3],
+	d4 Dependency[T4],
+	f func(T1, T2, T3, T4) CRIOE.ReaderIOEither[R],
+) DIE.Provider {
+	return MakeProvider5(
+		token,
+		InjContext.Identity(),
+		d1,
+		d2,
+		d3,
+		d4,
+		func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4) IOE.IOEither[error, R] {
+			return f(t1, t2, t3, t4)(ctx)
+		},
+	)
+}
+
 // eraseProviderFactory5 creates a function that takes a variadic number of untyped arguments and from a function of 5 strongly typed arguments and 5 dependencies
 func eraseProviderFactory5[T1, T2, T3, T4, T5 any, R any](
 	d1 Dependency[T1],
@@ -375,8 +476,15 @@ func MakeProvider5[T1, T2, T3, T4, T5 any, R any](
 	d5 Dependency[T5],
 	f func(T1, T2, T3, T4, T5) IOE.IOEither[error, R],
 ) DIE.Provider {
-	return DIE.MakeProvider(
+	return DIE.MakeProviderWithDependencies(
 		token,
+		A.From[DIE.Dependency](
+			d1,
+			d2,
+			d3,
+			d4,
+			d5,
+		),
 		MakeProviderFactory5(
 			d1,
 			d2,
@@ -387,6 +495,31 @@ func MakeProvider5[T1, T2, T3, T4, T5 any, R any](
 		))
 }
 
+// MakeProviderReader5 creates a [DIE.Provider] for an [InjectionToken] from a function with 5 dependencies that returns a [CRIOE.ReaderIOEither].
+// The [context.Context] passed to the reader is resolved via [InjContext]
+func MakeProviderReader5[T1, T2, T3, T4, T5 any, R any](
+	token InjectionToken[R],
+	d1 Dependency[T1],
+	d2 Dependency[T2],
+	d3 Dependency[T3],
+	d4 Dependency[T4],
+	d5 Dependency[T5],
+	f func(T1, T2, T3, T4, T5) CRIOE.ReaderIOEither[R],
+) DIE.Provider {
+	return MakeProvider6(
+		token,
+		InjContext.Identity(),
+		d1,
+		d2,
+		d3,
+		d4,
+		d5,
+		func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) IOE.IOEither[error, R] {
+			return f(t1, t2, t3, t4, t5)(ctx)
+		},
+	)
+}
+
 // eraseProviderFactory6 creates a function that takes a variadic number of untyped arguments and from a function of 6 strongly typed arguments and 6 dependencies
 func eraseProviderFactory6[T1, T2, T3, T4, T5, T6 any, R any](
 	d1 Dependency[T1],
@@ -479,8 +612,16 @@ func MakeProvider6[T1, T2, T3, T4, T5, T6 any, R any](
 	d6 Dependency[T6],
 	f func(T1, T2, T3, T4, T5, T6) IOE.IOEither[error, R],
 ) DIE.Provider {
-	return DIE.MakeProvider(
+	return DIE.MakeProviderWithDependencies(
 		token,
+		A.From[DIE.Dependency](
+			d1,
+			d2,
+			d3,
+			d4,
+			d5,
+			d6,
+		),
 		MakeProviderFactory6(
 			d1,
 			d2,
@@ -492,6 +633,33 @@ func MakeProvider6[T1, T2, T3, T4, T5, T6 any, R any](
 		))
 }
 
+// MakeProviderReader6 creates a [DIE.Provider] for an [InjectionToken] from a function with 6 dependencies that returns a [CRIOE.ReaderIOEither].
+// The [context.Context] passed to the reader is resolved via [InjContext]
+func MakeProviderReader6[T1, T2, T3, T4, T5, T6 any, R any](
+	token InjectionToken[R],
+	d1 Dependency[T1],
+	d2 Dependency[T2],
+	d3 Dependency[T3],
+	d4 Dependency[T4],
+	d5 Dependency[T5],
+	d6 Dependency[T6],
+	f func(T1, T2, T3, T4, T5, T6) CRIOE.ReaderIOEither[R],
+) DIE.Provider {
+	return MakeProvider7(
+		token,
+		InjContext.Identity(),
+		d1,
+		d2,
+		d3,
+		d4,
+		d5,
+		d6,
+		func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) IOE.IOEither[error, R] {
+			return f(t1, t2, t3, t4, t5, t6)(ctx)
+		},
+	)
+}
+
 // eraseProviderFactory7 creates a function that takes a variadic number of untyped arguments and from a function of 7 strongly typed arguments and 7 dependencies
 func eraseProviderFactory7[T1, T2, T3, T4, T5, T6, T7 any, R any](
 	d1 Dependency[T1],
@@ -593,8 +761,17 @@ func MakeProvider7[T1, T2, T3, T4, T5, T6, T7 any, R any](
 	d7 Dependency[T7],
 	f func(T1, T2, T3, T4, T5, T6, T7) IOE.IOEither[error, R],
 ) DIE.Provider {
-	return DIE.MakeProvider(
+	return DIE.MakeProviderWithDependencies(
 		token,
+		A.From[DIE.Dependency](
+			d1,
+			d2,
+			d3,
+			d4,
+			d5,
+			d6,
+			d7,
+		),
 		MakeProviderFactory7(
 			d1,
 			d2,
@@ -607,6 +784,35 @@ func MakeProvider7[T1, T2, T3, T4, T5, T6, T7 any, R any](
 		))
 }
 
+// MakeProviderReader7 creates a [DIE.Provider] for an [InjectionToken] from a function with 7 dependencies that returns a [CRIOE.ReaderIOEither].
+// The [context.Context] passed to the reader is resolved via [InjContext]
+func MakeProviderReader7[T1, T2, T3, T4, T5, T6, T7 any, R any](
+	token InjectionToken[R],
+	d1 Dependency[T1],
+	d2 Dependency[T2],
+	d3 Dependency[T3],
+	d4 Dependency[T4],
+	d5 Dependency[T5],
+	d6 Dependency[T6],
+	d7 Dependency[T7],
+	f func(T1, T2, T3, T4, T5, T6, T7) CRIOE.ReaderIOEither[R],
+) DIE.Provider {
+	return MakeProvider8(
+		token,
+		InjContext.Identity(),
+		d1,
+		d2,
+		d3,
+		d4,
+		d5,
+		d6,
+		d7,
+		func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) IOE.IOEither[error, R] {
+			return f(t1, t2, t3, t4, t5, t6, t7)(ctx)
+		},
+	)
+}
+
 // eraseProviderFactory8 creates a function that takes a variadic number of untyped arguments and from a function of 8 strongly typed arguments and 8 dependencies
 func eraseProviderFactory8[T1, T2, T3, T4, T5, T6, T7, T8 any, R any](
 	d1 Dependency[T1],
@@ -717,8 +923,18 @@ func MakeProvider8[T1, T2, T3, T4, T5, T6, T7, T8 any, R any](
 	d8 Dependency[T8],
 	f func(T1, T2, T3, T4, T5, T6, T7, T8) IOE.IOEither[error, R],
 ) DIE.Provider {
-	return DIE.MakeProvider(
+	return DIE.MakeProviderWithDependencies(
 		token,
+		A.From[DIE.Dependency](
+			d1,
+			d2,
+			d3,
+			d4,
+			d5,
+			d6,
+			d7,
+			d8,
+		),
 		MakeProviderFactory8(
 			d1,
 			d2,
@@ -732,6 +948,37 @@ func MakeProvider8[T1, T2, T3, T4, T5, T6, T7, T8 any, R any](
 		))
 }
 
+// MakeProviderReader8 creates a [DIE.Provider] for an [InjectionToken] from a function with 8 dependencies that returns a [CRIOE.ReaderIOEither].
+// The [context.Context] passed to the reader is resolved via [InjContext]
+func MakeProviderReader8[T1, T2, T3, T4, T5, T6, T7, T8 any, R any](
+	token InjectionToken[R],
+	d1 Dependency[T1],
+	d2 Dependency[T2],
+	d3 Dependency[T3],
+	d4 Dependency[T4],
+	d5 Dependency[T5],
+	d6 Dependency[T6],
+	d7 Dependency[T7],
+	d8 Dependency[T8],
+	f func(T1, T2, T3, T4, T5, T6, T7, T8) CRIOE.ReaderIOEither[R],
+) DIE.Provider {
+	return MakeProvider9(
+		token,
+		InjContext.Identity(),
+		d1,
+		d2,
+		d3,
+		d4,
+		d5,
+		d6,
+		d7,
+		d8,
+		func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) IOE.IOEither[error, R] {
+			return f(t1, t2, t3, t4, t5, t6, t7, t8)(ctx)
+		},
+	)
+}
+
 // eraseProviderFactory9 creates a function that takes a variadic number of untyped arguments and from a function of 9 strongly typed arguments and 9 dependencies
 func eraseProviderFactory9[T1, T2, T3, T4, T5, T6, T7, T8, T9 any, R any](
 	d1 Dependency[T1],
@@ -851,8 +1098,19 @@ func MakeProvider9[T1, T2, T3, T4, T5, T6, T7, T8, T9 any, R any](
 	d9 Dependency[T9],
 	f func(T1, T2, T3, T4, T5, T6, T7, T8, T9) IOE.IOEither[error, R],
 ) DIE.Provider {
-	return DIE.MakeProvider(
+	return DIE.MakeProviderWithDependencies(
 		token,
+		A.From[DIE.Dependency](
+			d1,
+			d2,
+			d3,
+			d4,
+			d5,
+			d6,
+			d7,
+			d8,
+			d9,
+		),
 		MakeProviderFactory9(
 			d1,
 			d2,
@@ -867,6 +1125,39 @@ func MakeProvider9[T1, T2, T3, T4, T5, T6, T7, T8, T9 any, R any](
 		))
 }
 
+// MakeProviderReader9 creates a [DIE.Provider] for an [InjectionToken] from a function with 9 dependencies that returns a [CRIOE.ReaderIOEither].
+// The [context.Context] passed to the reader is resolved via [InjContext]
+func MakeProviderReader9[T1, T2, T3, T4, T5, T6, T7, T8, T9 any, R any](
+	token InjectionToken[R],
+	d1 Dependency[T1],
+	d2 Dependency[T2],
+	d3 Dependency[T3],
+	d4 Dependency[T4],
+	d5 Dependency[T5],
+	d6 Dependency[T6],
+	d7 Dependency[T7],
+	d8 Dependency[T8],
+	d9 Dependency[T9],
+	f func(T1, T2, T3, T4, T5, T6, T7, T8, T9) CRIOE.ReaderIOEither[R],
+) DIE.Provider {
+	return MakeProvider10(
+		token,
+		InjContext.Identity(),
+		d1,
+		d2,
+		d3,
+		d4,
+		d5,
+		d6,
+		d7,
+		d8,
+		d9,
+		func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9) IOE.IOEither[error, R] {
+			return f(t1, t2, t3, t4, t5, t6, t7, t8, t9)(ctx)
+		},
+	)
+}
+
 // eraseProviderFactory10 creates a function that takes a variadic number of untyped arguments and from a function of 10 strongly typed arguments and 10 dependencies
 func eraseProviderFactory10[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10 any, R any](
 	d1 Dependency[T1],
@@ -995,8 +1286,20 @@ func MakeProvider10[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10 any, R any](
 	d10 Dependency[T10],
 	f func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10) IOE.IOEither[error, R],
 ) DIE.Provider {
-	return DIE.MakeProvider(
+	return DIE.MakeProviderWithDependencies(
 		token,
+		A.From[DIE.Dependency](
+			d1,
+			d2,
+			d3,
+			d4,
+			d5,
+			d6,
+			d7,
+			d8,
+			d9,
+			d10,
+		),
 		MakeProviderFactory10(
 			d1,
 			d2,
@@ -1012,6 +1315,41 @@ func MakeProvider10[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10 any, R any](
 		))
 }
 
+// MakeProviderReader10 creates a [DIE.Provider] for an [InjectionToken] from a function with 10 dependencies that returns a [CRIOE.ReaderIOEither].
+// The [context.Context] passed to the reader is resolved via [InjContext]
+func MakeProviderReader10[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10 any, R any](
+	token InjectionToken[R],
+	d1 Dependency[T1],
+	d2 Dependency[T2],
+	d3 Dependency[T3],
+	d4 Dependency[T4],
+	d5 Dependency[T5],
+	d6 Dependency[T6],
+	d7 Dependency[T7],
+	d8 Dependency[T8],
+	d9 Dependency[T9],
+	d10 Dependency[T10],
+	f func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10) CRIOE.ReaderIOEither[R],
+) DIE.Provider {
+	return MakeProvider11(
+		token,
+		InjContext.Identity(),
+		d1,
+		d2,
+		d3,
+		d4,
+		d5,
+		d6,
+		d7,
+		d8,
+		d9,
+		d10,
+		func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9, t10 T10) IOE.IOEither[error, R] {
+			return f(t1, t2, t3, t4, t5, t6, t7, t8, t9, t10)(ctx)
+		},
+	)
+}
+
 // eraseProviderFactory11 creates a function that takes a variadic number of untyped arguments and from a function of 11 strongly typed arguments and 11 dependencies
 func eraseProviderFactory11[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11 any, R any](
 	d1 Dependency[T1],
@@ -1149,8 +1487,21 @@ func MakeProvider11[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11 any, R any](
 	d11 Dependency[T11],
 	f func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11) IOE.IOEither[error, R],
 ) DIE.Provider {
-	return DIE.MakeProvider(
+	return DIE.MakeProviderWithDependencies(
 		token,
+		A.From[DIE.Dependency](
+			d1,
+			d2,
+			d3,
+			d4,
+			d5,
+			d6,
+			d7,
+			d8,
+			d9,
+			d10,
+			d11,
+		),
 		MakeProviderFactory11(
 			d1,
 			d2,
@@ -1167,6 +1518,43 @@ func MakeProvider11[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11 any, R any](
 		))
 }
 
+// MakeProviderReader11 creates a [DIE.Provider] for an [InjectionToken] from a function with 11 dependencies that returns a [CRIOE.ReaderIOEither].
+// The [context.Context] passed to the reader is resolved via [InjContext]
+func MakeProviderReader11[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11 any, R any](
+	token InjectionToken[R],
+	d1 Dependency[T1],
+	d2 Dependency[T2],
+	d3 Dependency[T3],
+	d4 Dependency[T4],
+	d5 Dependency[T5],
+	d6 Dependency[T6],
+	d7 Dependency[T7],
+	d8 Dependency[T8],
+	d9 Dependency[T9],
+	d10 Dependency[T10],
+	d11 Dependency[T11],
+	f func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11) CRIOE.ReaderIOEither[R],
+) DIE.Provider {
+	return MakeProvider12(
+		token,
+		InjContext.Identity(),
+		d1,
+		d2,
+		d3,
+		d4,
+		d5,
+		d6,
+		d7,
+		d8,
+		d9,
+		d10,
+		d11,
+		func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9, t10 T10, t11 T11) IOE.IOEither[error, R] {
+			return f(t1, t2, t3, t4, t5, t6, t7, t8, t9, t10, t11)(ctx)
+		},
+	)
+}
+
 // eraseProviderFactory12 creates a function that takes a variadic number of untyped arguments and from a function of 12 strongly typed arguments and 12 dependencies
 func eraseProviderFactory12[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12 any, R any](
 	d1 Dependency[T1],
@@ -1313,8 +1701,22 @@ func MakeProvider12[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12 any, R any
 	d12 Dependency[T12],
 	f func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12) IOE.IOEither[error, R],
 ) DIE.Provider {
-	return DIE.MakeProvider(
+	return DIE.MakeProviderWithDependencies(
 		token,
+		A.From[DIE.Dependency](
+			d1,
+			d2,
+			d3,
+			d4,
+			d5,
+			d6,
+			d7,
+			d8,
+			d9,
+			d10,
+			d11,
+			d12,
+		),
 		MakeProviderFactory12(
 			d1,
 			d2,
@@ -1332,6 +1734,45 @@ func MakeProvider12[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12 any, R any
 		))
 }
 
+// MakeProviderReader12 creates a [DIE.Provider] for an [InjectionToken] from a function with 12 dependencies that returns a [CRIOE.ReaderIOEither].
+// The [context.Context] passed to the reader is resolved via [InjContext]
+func MakeProviderReader12[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12 any, R any](
+	token InjectionToken[R],
+	d1 Dependency[T1],
+	d2 Dependency[T2],
+	d3 Dependency[T3],
+	d4 Dependency[T4],
+	d5 Dependency[T5],
+	d6 Dependency[T6],
+	d7 Dependency[T7],
+	d8 Dependency[T8],
+	d9 Dependency[T9],
+	d10 Dependency[T10],
+	d11 Dependency[T11],
+	d12 Dependency[T12],
+	f func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12) CRIOE.ReaderIOEither[R],
+) DIE.Provider {
+	return MakeProvider13(
+		token,
+		InjContext.Identity(),
+		d1,
+		d2,
+		d3,
+		d4,
+		d5,
+		d6,
+		d7,
+		d8,
+		d9,
+		d10,
+		d11,
+		d12,
+		func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9, t10 T10, t11 T11, t12 T12) IOE.IOEither[error, R] {
+			return f(t1, t2, t3, t4, t5, t6, t7, t8, t9, t10, t11, t12)(ctx)
+		},
+	)
+}
+
 // eraseProviderFactory13 creates a function that takes a variadic number of untyped arguments and from a function of 13 strongly typed arguments and 13 dependencies
 func eraseProviderFactory13[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13 any, R any](
 	d1 Dependency[T1],
@@ -1487,8 +1928,23 @@ func MakeProvider13[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13 any,
 	d13 Dependency[T13],
 	f func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13) IOE.IOEither[error, R],
 ) DIE.Provider {
-	return DIE.MakeProvider(
+	return DIE.MakeProviderWithDependencies(
 		token,
+		A.From[DIE.Dependency](
+			d1,
+			d2,
+			d3,
+			d4,
+			d5,
+			d6,
+			d7,
+			d8,
+			d9,
+			d10,
+			d11,
+			d12,
+			d13,
+		),
 		MakeProviderFactory13(
 			d1,
 			d2,
@@ -1507,6 +1963,47 @@ func MakeProvider13[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13 any,
 		))
 }
 
+// MakeProviderReader13 creates a [DIE.Provider] for an [InjectionToken] from a function with 13 dependencies that returns a [CRIOE.ReaderIOEither].
+// The [context.Context] passed to the reader is resolved via [InjContext]
+func MakeProviderReader13[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13 any, R any](
+	token InjectionToken[R],
+	d1 Dependency[T1],
+	d2 Dependency[T2],
+	d3 Dependency[T3],
+	d4 Dependency[T4],
+	d5 Dependency[T5],
+	d6 Dependency[T6],
+	d7 Dependency[T7],
+	d8 Dependency[T8],
+	d9 Dependency[T9],
+	d10 Dependency[T10],
+	d11 Dependency[T11],
+	d12 Dependency[T12],
+	d13 Dependency[T13],
+	f func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13) CRIOE.ReaderIOEither[R],
+) DIE.Provider {
+	return MakeProvider14(
+		token,
+		InjContext.Identity(),
+		d1,
+		d2,
+		d3,
+		d4,
+		d5,
+		d6,
+		d7,
+		d8,
+		d9,
+		d10,
+		d11,
+		d12,
+		d13,
+		func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9, t10 T10, t11 T11, t12 T12, t13 T13) IOE.IOEither[error, R] {
+			return f(t1, t2, t3, t4, t5, t6, t7, t8, t9, t10, t11, t12, t13)(ctx)
+		},
+	)
+}
+
 // eraseProviderFactory14 creates a function that takes a variadic number of untyped arguments and from a function of 14 strongly typed arguments and 14 dependencies
 func eraseProviderFactory14[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14 any, R any](
 	d1 Dependency[T1],
@@ -1671,8 +2168,24 @@ func MakeProvider14[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14
 	d14 Dependency[T14],
 	f func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14) IOE.IOEither[error, R],
 ) DIE.Provider {
-	return DIE.MakeProvider(
+	return DIE.MakeProviderWithDependencies(
 		token,
+		A.From[DIE.Dependency](
+			d1,
+			d2,
+			d3,
+			d4,
+			d5,
+			d6,
+			d7,
+			d8,
+			d9,
+			d10,
+			d11,
+			d12,
+			d13,
+			d14,
+		),
 		MakeProviderFactory14(
 			d1,
 			d2,
@@ -1692,6 +2205,49 @@ func MakeProvider14[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14
 		))
 }
 
+// MakeProviderReader14 creates a [DIE.Provider] for an [InjectionToken] from a function with 14 dependencies that returns a [CRIOE.ReaderIOEither].
+// The [context.Context] passed to the reader is resolved via [InjContext]
+func MakeProviderReader14[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14 any, R any](
+	token InjectionToken[R],
+	d1 Dependency[T1],
+	d2 Dependency[T2],
+	d3 Dependency[T3],
+	d4 Dependency[T4],
+	d5 Dependency[T5],
+	d6 Dependency[T6],
+	d7 Dependency[T7],
+	d8 Dependency[T8],
+	d9 Dependency[T9],
+	d10 Dependency[T10],
+	d11 Dependency[T11],
+	d12 Dependency[T12],
+	d13 Dependency[T13],
+	d14 Dependency[T14],
+	f func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14) CRIOE.ReaderIOEither[R],
+) DIE.Provider {
+	return MakeProvider15(
+		token,
+		InjContext.Identity(),
+		d1,
+		d2,
+		d3,
+		d4,
+		d5,
+		d6,
+		d7,
+		d8,
+		d9,
+		d10,
+		d11,
+		d12,
+		d13,
+		d14,
+		func(ctx context.Context, t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8, t9 T9, t10 T10, t11 T11, t12 T12, t13 T13, t14 T14) IOE.IOEither[error, R] {
+			return f(t1, t2, t3, t4, t5, t6, t7, t8, t9, t10, t11, t12, t13, t14)(ctx)
+		},
+	)
+}
+
 // eraseProviderFactory15 creates a function that takes a variadic number of untyped arguments and from a function of 15 strongly typed arguments and 15 dependencies
 func eraseProviderFactory15[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15 any, R any](
 	d1 Dependency[T1],
@@ -1865,8 +2421,25 @@ func MakeProvider15[T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14,
 	d15 Dependency[T15],
 	f func(T1, T2, T3, T4, T5, T6, T7, T8, T9, T10, T11, T12, T13, T14, T15) IOE.IOEither[error, R],
 ) DIE.Provider {
-	return DIE.MakeProvider(
+	return DIE.MakeProviderWithDependencies(
 		token,
+		A.From[DIE.Dependency](
+			d1,
+			d2,
+			d3,
+			d4,
+			d5,
+			d6,
+			d7,
+			d8,
+			d9,
+			d10,
+			d11,
+			d12,
+			d13,
+			d14,
+			d15,
+		),
 		MakeProviderFactory15(
 			d1,
 			d2,
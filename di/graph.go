@@ -0,0 +1,73 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package di
+
+import (
+	A "github.com/IBM/fp-go/array"
+	DIE "github.com/IBM/fp-go/di/erasure"
+	F "github.com/IBM/fp-go/function"
+	R "github.com/IBM/fp-go/record"
+	T "github.com/IBM/fp-go/tuple"
+)
+
+// GraphEdge describes the dependency of the provider for the token named `From` on the token named `To`
+type GraphEdge struct {
+	From string
+	To   string
+}
+
+// providerName returns the id of the token provided by a [DIE.Provider] together with its name
+func providerName(p DIE.Provider) T.Tuple2[string, string] {
+	dep := p.Provides()
+	return T.MakeTuple2(dep.Id(), dep.String())
+}
+
+// dependencies returns the dependencies declared by a [DIE.Provider], if any
+func dependencies(p DIE.Provider) []DIE.Dependency {
+	if pd, ok := p.(DIE.ProviderWithDependencies); ok {
+		return pd.Dependencies()
+	}
+	return A.Empty[DIE.Dependency]()
+}
+
+// providerEdges returns the edges from the token provided by a [DIE.Provider] to each of its declared dependencies
+func providerEdges(names map[string]string) func(DIE.Provider) []GraphEdge {
+	// the name of the token of a dependency, independent of the kind of the dependency
+	nameOf := func(dep DIE.Dependency) string {
+		if name, ok := names[dep.Id()]; ok {
+			return name
+		}
+		return dep.String()
+	}
+	return func(p DIE.Provider) []GraphEdge {
+		from := p.Provides().String()
+		return F.Pipe1(
+			dependencies(p),
+			A.Map(func(dep DIE.Dependency) GraphEdge {
+				return GraphEdge{From: from, To: nameOf(dep)}
+			}),
+		)
+	}
+}
+
+// DescribeGraph returns the edges of the dependency graph spanned by a set of [DIE.Provider]s without resolving any of them,
+// e.g. to render the graph for debugging purposes. Optional and lazy dependencies point to the name of the provided token,
+// so every edge ends at a node that is also the start of edges. Only dependencies on tokens without a provider keep the name
+// of the dependency itself. Providers that do not implement [DIE.ProviderWithDependencies] contribute no edges.
+func DescribeGraph(providers []DIE.Provider) []GraphEdge {
+	names := R.FromEntries(A.Map(providerName)(providers))
+	return A.Chain(providerEdges(names))(providers)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package di
+
+import (
+	"testing"
+
+	A "github.com/IBM/fp-go/array"
+	F "github.com/IBM/fp-go/function"
+	IOE "github.com/IBM/fp-go/ioeither"
+	O "github.com/IBM/fp-go/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeGraph(t *testing.T) {
+
+	p1 := ConstProvider(INJ_KEY1, "Carsten")
+	p2 := MakeProvider1(INJ_KEY2, INJ_KEY1.Identity(), IOE.Of[error, string])
+	p3 := MakeProvider2(INJ_KEY3, INJ_KEY2.Identity(), INJ_KEY1.Option(), func(s string, _ O.Option[string]) IOE.IOEither[error, string] {
+		return IOE.Of[error](s)
+	})
+	injKey4 := MakeToken[string]("INJ_KEY4")
+	p4 := MakeProvider1(injKey4, INJ_KEY3.IOEither(), F.Identity[IOE.IOEither[error, string]])
+
+	edges := DescribeGraph(A.From(p1, p2, p3, p4))
+
+	assert.Equal(t, []GraphEdge{
+		{From: "INJ_KEY2", To: "INJ_KEY1"},
+		{From: "INJ_KEY3", To: "INJ_KEY2"},
+		{From: "INJ_KEY3", To: "INJ_KEY1"},
+		{From: "INJ_KEY4", To: "INJ_KEY3"},
+	}, edges)
+}
@@ -77,3 +77,17 @@ func MakeProvider0[R any](
 func ConstProvider[R any](token InjectionToken[R], value R) DIE.Provider {
 	return MakeProvider0[R](token, IOE.Of[error](value))
 }
+
+// MakeProviderFromMulti creates a [DIE.Provider] for an [InjectionToken] from a function that depends on all items
+// provided for a [MultiInjectionToken]
+func MakeProviderFromMulti[T, R any](
+	token InjectionToken[R],
+	multi MultiInjectionToken[T],
+	fct func([]T) IOE.IOEither[error, R],
+) DIE.Provider {
+	return MakeProvider1(
+		token,
+		multi.Container().Identity(),
+		fct,
+	)
+}
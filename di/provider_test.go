@@ -24,8 +24,10 @@ import (
 	DIE "github.com/IBM/fp-go/di/erasure"
 	E "github.com/IBM/fp-go/either"
 	F "github.com/IBM/fp-go/function"
+	I "github.com/IBM/fp-go/identity"
 	IOE "github.com/IBM/fp-go/ioeither"
 	O "github.com/IBM/fp-go/option"
+	S "github.com/IBM/fp-go/string"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -344,3 +346,132 @@ func TestTokenWithDefaultProviderAndOverride(t *testing.T) {
 	// r3 should work
 	assert.Equal(t, E.Of[error]("Token: Override"), r3(inj)())
 }
+
+func TestProviderFromMulti(t *testing.T) {
+
+	type Logger = func(string) string
+
+	// define the tokens
+	injLoggers := MakeMultiToken[Logger]("loggers")
+	injLogger := MakeToken[Logger]("logger")
+
+	prefixLogger := func(prefix string) Logger {
+		return func(msg string) string {
+			return fmt.Sprintf("%s: %s", prefix, msg)
+		}
+	}
+
+	// provide some loggers
+	l1 := ConstProvider(injLoggers.Item(), prefixLogger("console"))
+	l2 := ConstProvider(injLoggers.Item(), prefixLogger("file"))
+	l3 := ConstProvider(injLoggers.Item(), prefixLogger("remote"))
+
+	// the composite logger forwards to all loggers
+	composite := MakeProviderFromMulti(injLogger, injLoggers, func(loggers []Logger) IOE.IOEither[error, Logger] {
+		return IOE.Of[error](func(msg string) string {
+			return F.Pipe2(
+				loggers,
+				A.Map(I.Ap[string](msg)),
+				A.Intercalate(S.Monoid)(", "),
+			)
+		})
+	})
+
+	// populate the injector
+	inj := DIE.MakeInjector(A.From(l1, l2, composite, l3))
+
+	logger := Resolve(injLogger)(inj)()
+
+	assert.Equal(t, E.Of[error]("console: Hello, file: Hello, remote: Hello"), F.Pipe1(
+		logger,
+		E.Map[error](I.Ap[string, string]("Hello")),
+	))
+}
+
+func TestCyclicDependency(t *testing.T) {
+	// the tokens forming the cycle
+	injA := MakeToken[string]("A")
+	injB := MakeToken[string]("B")
+	injC := MakeToken[string]("C")
+
+	concat := func(value string) IOE.IOEither[error, string] {
+		return IOE.Of[error](fmt.Sprintf("Value: %s", value))
+	}
+
+	pA := MakeProvider1(injA, injB.Identity(), concat)
+	pB := MakeProvider1(injB, injC.Identity(), concat)
+	pC := MakeProvider1(injC, injA.Identity(), concat)
+
+	// populate the injector
+	inj := DIE.MakeInjector(A.From(pA, pB, pC))
+
+	res := Resolve(injA)(inj)()
+
+	assert.Equal(t, E.Left[string](fmt.Errorf("cyclic dependency [A -> B -> C -> A]")), res)
+}
+
+func TestDiamondDependency(t *testing.T) {
+	// the tokens forming the diamond
+	injA := MakeToken[string]("A")
+	injB := MakeToken[string]("B")
+	injC := MakeToken[string]("C")
+	injD := MakeToken[string]("D")
+
+	var count int
+
+	pA := MakeProvider0(injA, func() E.Either[error, string] {
+		count++
+		return E.Of[error]("A")
+	})
+	pB := MakeProvider1(injB, injA.Identity(), func(a string) IOE.IOEither[error, string] {
+		return IOE.Of[error](fmt.Sprintf("B(%s)", a))
+	})
+	pC := MakeProvider1(injC, injA.Identity(), func(a string) IOE.IOEither[error, string] {
+		return IOE.Of[error](fmt.Sprintf("C(%s)", a))
+	})
+	pD := MakeProvider2(injD, injB.Identity(), injC.Identity(), func(b, c string) IOE.IOEither[error, string] {
+		return IOE.Of[error](fmt.Sprintf("D(%s, %s)", b, c))
+	})
+
+	// populate the injector
+	inj := DIE.MakeInjector(A.From(pA, pB, pC, pD))
+
+	res := Resolve(injD)(inj)()
+
+	assert.Equal(t, E.Of[error]("D(B(A), C(A))"), res)
+	assert.Equal(t, 1, count)
+}
+
+func TestChildInjector(t *testing.T) {
+	injConfig := MakeToken[string]("Config")
+	injDB := MakeToken[string]("DB")
+	injService := MakeToken[string]("Service")
+
+	var configCount int
+
+	pConfig := MakeProvider0(injConfig, func() E.Either[error, string] {
+		configCount++
+		return E.Of[error]("Config")
+	})
+	pDB := MakeProvider1(injDB, injConfig.Identity(), func(cfg string) IOE.IOEither[error, string] {
+		return IOE.Of[error](fmt.Sprintf("DB(%s)", cfg))
+	})
+	pMockDB := MakeProvider1(injDB, injConfig.Identity(), func(cfg string) IOE.IOEither[error, string] {
+		return IOE.Of[error](fmt.Sprintf("MockDB(%s)", cfg))
+	})
+	pService := MakeProvider1(injService, injDB.Identity(), func(db string) IOE.IOEither[error, string] {
+		return IOE.Of[error](fmt.Sprintf("Service(%s)", db))
+	})
+
+	parent := DIE.MakeInjector(A.From(pConfig, pDB, pService))
+	child := DIE.MakeChildInjector(parent, A.From(pMockDB, pService))
+
+	// the child uses the mock
+	assert.Equal(t, E.Of[error]("Service(MockDB(Config))"), Resolve(injService)(child)())
+	assert.Equal(t, E.Of[error]("MockDB(Config)"), Resolve(injDB)(child)())
+	// the parent is unaffected
+	assert.Equal(t, E.Of[error]("Service(DB(Config))"), Resolve(injService)(parent)())
+	assert.Equal(t, E.Of[error]("DB(Config)"), Resolve(injDB)(parent)())
+	// the inherited config is shared
+	assert.Equal(t, 1, configCount)
+}
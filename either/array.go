@@ -16,8 +16,11 @@
 package either
 
 import (
+	"errors"
+
 	F "github.com/IBM/fp-go/function"
 	RA "github.com/IBM/fp-go/internal/array"
+	T "github.com/IBM/fp-go/tuple"
 )
 
 // TraverseArrayG transforms an array
@@ -72,3 +75,49 @@ func CompactArrayG[A1 ~[]Either[E, A], A2 ~[]A, E, A any](fa A1) A2 {
 func CompactArray[E, A any](fa []Either[E, A]) []A {
 	return CompactArrayG[[]Either[E, A], []A](fa)
 }
+
+// PartitionMapArrayG splits an array into the left and the right values returned by the function, preserving the original order
+func PartitionMapArrayG[GA ~[]A, GE ~[]E, GB ~[]B, E, A, B any](f func(A) Either[E, B]) func(GA) T.Tuple2[GE, GB] {
+	return func(as GA) T.Tuple2[GE, GB] {
+		return RA.Reduce(as, func(out T.Tuple2[GE, GB], a A) T.Tuple2[GE, GB] {
+			return MonadFold(f(a), func(e E) T.Tuple2[GE, GB] {
+				return T.MakeTuple2(RA.Append(out.F1, e), out.F2)
+			}, func(b B) T.Tuple2[GE, GB] {
+				return T.MakeTuple2(out.F1, RA.Append(out.F2, b))
+			})
+		}, T.MakeTuple2(RA.Empty[GE](), RA.Empty[GB]()))
+	}
+}
+
+// PartitionMapArray splits an array into the left and the right values returned by the function, preserving the original order
+func PartitionMapArray[E, A, B any](f func(A) Either[E, B]) func([]A) T.Tuple2[[]E, []B] {
+	return PartitionMapArrayG[[]A, []E, []B](f)
+}
+
+// FilterMapArrayG maps an array with a function returning an [Either], it keeps the right values and discards the left values
+func FilterMapArrayG[GA ~[]A, GB ~[]B, E, A, B any](f func(A) Either[E, B]) func(GA) GB {
+	return func(as GA) GB {
+		return RA.Reduce(as, func(out GB, a A) GB {
+			return MonadFold(f(a), F.Constant1[E](out), F.Bind1st(RA.Append[GB, B], out))
+		}, make(GB, 0, len(as)))
+	}
+}
+
+// FilterMapArray maps an array with a function returning an [Either], it keeps the right values and discards the left values.
+// Also refer to [FlatMapEither]
+func FilterMapArray[E, A, B any](f func(A) Either[E, B]) func([]A) []B {
+	return FilterMapArrayG[[]A, []B](f)
+}
+
+// FlatMapEither is the [Either] counterpart of array.FlatMapOption, it is an alias for [FilterMapArray].
+// It lives in this package because the array package cannot depend on [Either]
+func FlatMapEither[E, A, B any](f func(A) Either[E, B]) func([]A) []B {
+	return FilterMapArray(f)
+}
+
+// UnwrapErrors converts an array of [Either]s into the idiomatic tuple of all right values and the joined left values,
+// the error is nil if there are no left values. Also refer to [UnwrapError]
+func UnwrapErrors[A any](as []Either[error, A]) ([]A, error) {
+	parts := PartitionMapArray[error](F.Identity[Either[error, A]])(as)
+	return parts.F2, errors.Join(parts.F1...)
+}
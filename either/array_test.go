@@ -1,10 +1,13 @@
 package either
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"testing"
 
 	TST "github.com/IBM/fp-go/internal/testing"
+	T "github.com/IBM/fp-go/tuple"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -48,3 +51,53 @@ func TestSequenceArrayError(t *testing.T) {
 	// run across four bits
 	s(4)(t)
 }
+
+func TestPartitionMapArray(t *testing.T) {
+	f := PartitionMapArray(func(n int) Either[string, int] {
+		if n%2 == 0 {
+			return Right[string](n * 10)
+		}
+		return Left[int](fmt.Sprintf("odd %d", n))
+	})
+
+	assert.Equal(t, T.MakeTuple2([]string{}, []int{}), f([]int{}))
+	assert.Equal(t, T.MakeTuple2([]string{"odd 1", "odd 3"}, []int{20, 40}), f([]int{1, 2, 3, 4}))
+	// all values on one side
+	assert.Equal(t, T.MakeTuple2([]string{}, []int{20, 40}), f([]int{2, 4}))
+	assert.Equal(t, T.MakeTuple2([]string{"odd 1", "odd 3"}, []int{}), f([]int{1, 3}))
+}
+
+func TestFilterMapArray(t *testing.T) {
+	f := FilterMapArray(func(n int) Either[string, int] {
+		if n%2 == 0 {
+			return Right[string](n * 10)
+		}
+		return Left[int](fmt.Sprintf("odd %d", n))
+	})
+
+	assert.Equal(t, []int{}, f([]int{}))
+	assert.Equal(t, []int{20, 40}, f([]int{1, 2, 3, 4}))
+	assert.Equal(t, []int{}, f([]int{1, 3}))
+}
+
+func TestFlatMapEither(t *testing.T) {
+	f := FlatMapEither(func(s string) Either[error, int] {
+		return TryCatchError(strconv.Atoi(s))
+	})
+
+	assert.Equal(t, []int{1, 3}, f([]string{"1", "a", "3"}))
+}
+
+func TestUnwrapErrors(t *testing.T) {
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+
+	as, err := UnwrapErrors([]Either[error, int]{Of[error](1), Left[int](err1), Of[error](2), Left[int](err2)})
+	assert.Equal(t, []int{1, 2}, as)
+	assert.ErrorIs(t, err, err1)
+	assert.ErrorIs(t, err, err2)
+
+	as, err = UnwrapErrors([]Either[error, int]{Of[error](1), Of[error](2)})
+	assert.Equal(t, []int{1, 2}, as)
+	assert.NoError(t, err)
+}
@@ -156,10 +156,16 @@ func FromOption[A, E any](onNone func() E) func(O.Option[A]) Either[E, A] {
 	return O.Fold(F.Nullary2(onNone, Left[A, E]), Right[E, A])
 }
 
+// ToOption converts a [Right] into a Some and a [Left] into a None
 func ToOption[E, A any](ma Either[E, A]) O.Option[A] {
 	return MonadFold(ma, F.Ignore1of1[E](O.None[A]), O.Some[A])
 }
 
+// GetLeft converts a [Left] into a Some and a [Right] into a None, it is the dual of [ToOption]
+func GetLeft[E, A any](ma Either[E, A]) O.Option[E] {
+	return MonadFold(ma, O.Some[E], F.Ignore1of1[A](O.None[E]))
+}
+
 func FromError[A any](f func(a A) error) func(A) Either[error, A] {
 	return func(a A) Either[error, A] {
 		return TryCatchError(a, f(a))
@@ -190,6 +196,16 @@ func FromPredicate[E, A any](pred func(A) bool, onFalse func(A) E) func(A) Eithe
 	}
 }
 
+// MonadFilterOrElse converts a [Right] value that does not match the predicate into a [Left] created by onFalse. A [Left] value is returned unchanged
+func MonadFilterOrElse[E, A any](ma Either[E, A], pred func(A) bool, onFalse func(A) E) Either[E, A] {
+	return MonadChain(ma, FromPredicate(pred, onFalse))
+}
+
+// FilterOrElse converts a [Right] value that does not match the predicate into a [Left] created by onFalse. A [Left] value is returned unchanged
+func FilterOrElse[E, A any](pred func(A) bool, onFalse func(A) E) func(Either[E, A]) Either[E, A] {
+	return Chain(FromPredicate(pred, onFalse))
+}
+
 func FromNillable[A, E any](e E) func(*A) Either[E, *A] {
 	return FromPredicate(F.IsNonNil[A], F.Constant1[*A](e))
 }
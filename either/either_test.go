@@ -106,6 +106,16 @@ func TestChainOptionK(t *testing.T) {
 	assert.Equal(t, Left[int]("b"), f(Left[int]("b")))
 }
 
+func TestToOption(t *testing.T) {
+	assert.Equal(t, O.None[int](), ToOption(Left[int]("error")))
+	assert.Equal(t, O.Some(1), ToOption(Right[string](1)))
+}
+
+func TestGetLeft(t *testing.T) {
+	assert.Equal(t, O.Some("error"), GetLeft(Left[int]("error")))
+	assert.Equal(t, O.None[string](), GetLeft(Right[string](1)))
+}
+
 func TestFromOption(t *testing.T) {
 	assert.Equal(t, Left[int]("none"), FromOption[int](F.Constant("none"))(O.None[int]()))
 	assert.Equal(t, Right[string](1), FromOption[int](F.Constant("none"))(O.Some(1)))
@@ -127,3 +137,22 @@ func TestFromIO(t *testing.T) {
 
 	assert.Equal(t, Right[error]("abc"), e)
 }
+
+func TestFilterOrElse(t *testing.T) {
+	isPositive := func(n int) bool {
+		return n > 0
+	}
+	onFalse := func(n int) string {
+		return fmt.Sprintf("%d is not positive", n)
+	}
+	filter := FilterOrElse(isPositive, onFalse)
+
+	// right matching the predicate
+	assert.Equal(t, Right[string](1), filter(Right[string](1)))
+	// right not matching the predicate
+	assert.Equal(t, Left[int]("-1 is not positive"), filter(Right[string](-1)))
+	// left is untouched
+	assert.Equal(t, Left[int]("error"), filter(Left[int]("error")))
+
+	assert.Equal(t, Left[int]("0 is not positive"), MonadFilterOrElse(Right[string](0), isPositive, onFalse))
+}
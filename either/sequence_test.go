@@ -0,0 +1,53 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package either
+
+import (
+	"errors"
+	"testing"
+
+	T "github.com/IBM/fp-go/tuple"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSequenceT(t *testing.T) {
+	assert.Equal(t, Of[error](T.MakeTuple2(1, "a")), SequenceT2(Of[error](1), Of[error]("a")))
+	assert.Equal(t, Of[error](T.MakeTuple3(1, "a", true)), SequenceT3(Of[error](1), Of[error]("a"), Of[error](true)))
+	assert.Equal(t, Of[error](T.MakeTuple4(1, "a", true, 2)), SequenceT4(Of[error](1), Of[error]("a"), Of[error](true), Of[error](2)))
+}
+
+func TestSequenceTLeft(t *testing.T) {
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+
+	assert.Equal(t, Left[T.Tuple2[int, string]](err1), SequenceT2(Left[int](err1), Of[error]("a")))
+	assert.Equal(t, Left[T.Tuple3[int, string, bool]](err1), SequenceT3(Of[error](1), Left[string](err1), Of[error](true)))
+	// the first left wins
+	assert.Equal(t, Left[T.Tuple4[int, string, bool, int]](err1), SequenceT4(Of[error](1), Left[string](err1), Of[error](true), Left[int](err2)))
+}
+
+func TestSequenceTuple(t *testing.T) {
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+
+	assert.Equal(t, Of[error](T.MakeTuple2(1, "a")), SequenceTuple2(T.MakeTuple2(Of[error](1), Of[error]("a"))))
+	assert.Equal(t, Of[error](T.MakeTuple3(1, "a", true)), SequenceTuple3(T.MakeTuple3(Of[error](1), Of[error]("a"), Of[error](true))))
+	assert.Equal(t, Of[error](T.MakeTuple4(1, "a", true, 2)), SequenceTuple4(T.MakeTuple4(Of[error](1), Of[error]("a"), Of[error](true), Of[error](2))))
+
+	assert.Equal(t, Left[T.Tuple2[int, string]](err2), SequenceTuple2(T.MakeTuple2(Of[error](1), Left[string](err2))))
+	assert.Equal(t, Left[T.Tuple3[int, string, bool]](err1), SequenceTuple3(T.MakeTuple3(Left[int](err1), Of[error]("a"), Left[bool](err2))))
+	assert.Equal(t, Left[T.Tuple4[int, string, bool, int]](err2), SequenceTuple4(T.MakeTuple4(Of[error](1), Of[error]("a"), Left[bool](err2), Of[error](2))))
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package either
+
+import (
+	F "github.com/IBM/fp-go/function"
+	RA "github.com/IBM/fp-go/internal/array"
+	S "github.com/IBM/fp-go/semigroup"
+)
+
+// apV is a variant of [Ap] that combines the errors of both sides using the [S.Semigroup] instead of
+// short-circuiting on the first [Left]
+func apV[B, E, A any](sg S.Semigroup[E]) func(Either[E, A]) func(Either[E, func(A) B]) Either[E, B] {
+	return func(fa Either[E, A]) func(Either[E, func(A) B]) Either[E, B] {
+		return func(fab Either[E, func(A) B]) Either[E, B] {
+			return MonadFold(fab, func(e1 E) Either[E, B] {
+				return MonadFold(fa, func(e2 E) Either[E, B] {
+					return Left[B](sg.Concat(e1, e2))
+				}, F.Constant1[A](Left[B](e1)))
+			}, F.Bind1st(MonadMap[E, A, B], fa))
+		}
+	}
+}
+
+// TraverseArrayValidationG transforms an array and accumulates all errors using the [S.Semigroup]
+func TraverseArrayValidationG[GA ~[]A, GB ~[]B, E, A, B any](sg S.Semigroup[E]) func(func(A) Either[E, B]) func(GA) Either[E, GB] {
+	ap := apV[GB, E, B](sg)
+	return func(f func(A) Either[E, B]) func(GA) Either[E, GB] {
+		return RA.Traverse[GA](
+			Of[E, GB],
+			Map[E, GB, func(B) GB],
+			ap,
+
+			f,
+		)
+	}
+}
+
+// TraverseArrayValidation transforms an array. Unlike [TraverseArray] it does not stop at the first [Left]
+// but combines the errors of all failing elements using the [S.Semigroup]
+func TraverseArrayValidation[E, A, B any](sg S.Semigroup[E]) func(func(A) Either[E, B]) func([]A) Either[E, []B] {
+	return TraverseArrayValidationG[[]A, []B](sg)
+}
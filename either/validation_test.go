@@ -0,0 +1,43 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package either
+
+import (
+	"fmt"
+	"testing"
+
+	S "github.com/IBM/fp-go/semigroup"
+	STR "github.com/IBM/fp-go/string"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraverseArrayValidation(t *testing.T) {
+	sg := S.Intercalate("; ")(STR.Semigroup())
+
+	positive := TraverseArrayValidation[string, int, int](sg)(func(n int) Either[string, int] {
+		if n > 0 {
+			return Right[string](n * 2)
+		}
+		return Left[int](fmt.Sprintf("%d is not positive", n))
+	})
+
+	// all errors are accumulated in order
+	assert.Equal(t, Left[[]int]("0 is not positive; -1 is not positive; -2 is not positive"), positive([]int{0, 1, -1, 2, -2}))
+	// all elements succeed
+	assert.Equal(t, Right[string]([]int{2, 4, 6}), positive([]int{1, 2, 3}))
+	// empty input
+	assert.Equal(t, Right[string]([]int{}), positive([]int{}))
+}
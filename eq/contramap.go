@@ -24,3 +24,8 @@ func Contramap[A, B any](f func(b B) A) func(Eq[A]) Eq[B] {
 		})
 	}
 }
+
+// ByKey implements an Equals predicate that compares values by a comparable key
+func ByKey[A any, K comparable](key func(A) K) Eq[A] {
+	return Contramap(key)(FromStrictEquals[K]())
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type user struct {
+	id   int
+	name string
+}
+
+func userID(u user) int {
+	return u.id
+}
+
+// uniq keeps the first occurrence of equal values
+func uniq[A any](e Eq[A]) func([]A) []A {
+	return func(as []A) []A {
+		var result []A
+		for _, a := range as {
+			found := false
+			for _, r := range result {
+				if e.Equals(r, a) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				result = append(result, a)
+			}
+		}
+		return result
+	}
+}
+
+func TestContramap(t *testing.T) {
+	eqUser := Contramap(userID)(FromStrictEquals[int]())
+
+	assert.True(t, eqUser.Equals(user{1, "a"}, user{1, "b"}))
+	assert.False(t, eqUser.Equals(user{1, "a"}, user{2, "a"}))
+}
+
+func TestByKey(t *testing.T) {
+	users := []user{{1, "a"}, {2, "b"}, {1, "c"}, {3, "d"}, {2, "e"}}
+
+	assert.Equal(t, []user{{1, "a"}, {2, "b"}, {3, "d"}}, uniq(ByKey(userID))(users))
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eq
+
+import (
+	M "github.com/IBM/fp-go/monoid"
+)
+
+// Field returns an [Eq] for a structure `S` that compares a single field of the structure using the [Eq] for the field.
+// The field is accessed via a getter.
+func Field[S, A any](get func(S) A, e Eq[A]) Eq[S] {
+	return Contramap(get)(e)
+}
+
+// Struct combines the [Eq]s of individual fields, typically created via [Field], into an [Eq] for the whole structure.
+// Two structures are equal if all fields are equal, fields that are not covered are ignored.
+func Struct[S any](fields ...Eq[S]) Eq[S] {
+	return M.ConcatAll(Monoid[S]())(fields)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	address struct {
+		city string
+		zip  int
+	}
+
+	person struct {
+		name    string
+		age     int
+		address address
+		tags    []string
+	}
+)
+
+func TestStruct(t *testing.T) {
+	eqAddress := Struct(
+		Field(func(a address) string { return a.city }, FromStrictEquals[string]()),
+		Field(func(a address) int { return a.zip }, FromStrictEquals[int]()),
+	)
+
+	// tags are not compared
+	eqPerson := Struct(
+		Field(func(p person) string { return p.name }, FromStrictEquals[string]()),
+		Field(func(p person) int { return p.age }, FromStrictEquals[int]()),
+		Field(func(p person) address { return p.address }, eqAddress),
+	)
+
+	p1 := person{"Carsten", 42, address{"Böblingen", 71032}, []string{"a"}}
+	p2 := person{"Carsten", 42, address{"Böblingen", 71032}, []string{"b"}}
+	p3 := person{"Carsten", 42, address{"Stuttgart", 70173}, []string{"a"}}
+
+	assert.True(t, eqPerson.Equals(p1, p2))
+	assert.False(t, eqPerson.Equals(p1, p3))
+	// an empty struct equals everything
+	assert.True(t, Struct[person]().Equals(p1, p3))
+}
@@ -15,7 +15,10 @@
 
 package lazy
 
-import "sync"
+import (
+	"sync"
+	"time"
+)
 
 // Memoize computes the value of the provided IO monad lazily but exactly once
 func Memoize[GA ~func() A, A any](ma GA) GA {
@@ -32,3 +35,27 @@ func Memoize[GA ~func() A, A any](ma GA) GA {
 		return result
 	}
 }
+
+// MemoizeWithTTL computes the value of the provided IO monad lazily and caches it until the time-to-live has
+// elapsed since the last evaluation. Concurrent callers are serialized, so at most one evaluation happens at a time.
+func MemoizeWithTTL[GA ~func() A, A any](ttl time.Duration) func(GA) GA {
+	return func(ma GA) GA {
+		// synchronization primitives
+		var lock sync.Mutex
+		var result A
+		var expires time.Time
+		var cached bool
+		// returns our memoized wrapper
+		return func() A {
+			lock.Lock()
+			defer lock.Unlock()
+			// check if we need to recompute
+			if !cached || !time.Now().Before(expires) {
+				result = ma()
+				expires = time.Now().Add(ttl)
+				cached = true
+			}
+			return result
+		}
+	}
+}
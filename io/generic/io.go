@@ -133,6 +133,11 @@ func Memoize[GA ~func() A, A any](ma GA) GA {
 	return L.Memoize[GA, A](ma)
 }
 
+// MemoizeWithTTL computes the value of the provided IO monad lazily and caches it until the time-to-live has elapsed
+func MemoizeWithTTL[GA ~func() A, A any](ttl time.Duration) func(GA) GA {
+	return L.MemoizeWithTTL[GA, A](ttl)
+}
+
 // Delay creates an operation that passes in the value after some delay
 func Delay[GA ~func() A, A any](delay time.Duration) func(GA) GA {
 	return func(ga GA) GA {
@@ -29,3 +29,13 @@ func Bracket[E, A, B, ANY any](
 ) IOEither[E, B] {
 	return G.Bracket(acquire, use, release)
 }
+
+// BracketMany acquires the resources in order, passes them to the body action and releases them in reverse order. If an
+// acquisition fails, the resources that have already been acquired are released.
+func BracketMany[E, A, B, ANY any](
+	acquire []IOEither[E, A],
+	use func([]A) IOEither[E, B],
+	release func(A) IOEither[E, ANY],
+) IOEither[E, B] {
+	return G.BracketMany(acquire, use, release)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioeither
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	E "github.com/IBM/fp-go/either"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBracketMany(t *testing.T) {
+	var log []string
+
+	acquire := func(name string) IOEither[error, string] {
+		return func() E.Either[error, string] {
+			log = append(log, fmt.Sprintf("acquire %s", name))
+			return E.Of[error](name)
+		}
+	}
+	fail := func(name string) IOEither[error, string] {
+		return func() E.Either[error, string] {
+			log = append(log, fmt.Sprintf("fail %s", name))
+			return E.Left[string](fmt.Errorf("failed to acquire %s", name))
+		}
+	}
+	release := func(name string) IOEither[error, any] {
+		return func() E.Either[error, any] {
+			log = append(log, fmt.Sprintf("release %s", name))
+			return E.Of[error, any](name)
+		}
+	}
+	use := func(names []string) IOEither[error, string] {
+		return func() E.Either[error, string] {
+			log = append(log, "use")
+			return E.Of[error](strings.Join(names, ","))
+		}
+	}
+
+	t.Run("release in reverse order", func(t *testing.T) {
+		log = nil
+		res := BracketMany([]IOEither[error, string]{acquire("a"), acquire("b"), acquire("c")}, use, release)
+
+		assert.Equal(t, E.Of[error]("a,b,c"), res())
+		assert.Equal(t, []string{"acquire a", "acquire b", "acquire c", "use", "release c", "release b", "release a"}, log)
+	})
+
+	t.Run("release on partial acquisition", func(t *testing.T) {
+		log = nil
+		res := BracketMany([]IOEither[error, string]{acquire("a"), acquire("b"), fail("c")}, use, release)
+
+		assert.Equal(t, E.Left[string](errors.New("failed to acquire c")), res())
+		assert.Equal(t, []string{"acquire a", "acquire b", "fail c", "release b", "release a"}, log)
+	})
+
+	t.Run("release on failing use", func(t *testing.T) {
+		log = nil
+		err := errors.New("use failed")
+		res := BracketMany([]IOEither[error, string]{acquire("a"), acquire("b")}, func([]string) IOEither[error, string] {
+			return Left[string](err)
+		}, release)
+
+		assert.Equal(t, E.Left[string](err), res())
+		assert.Equal(t, []string{"acquire a", "acquire b", "release b", "release a"}, log)
+	})
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioeither
+
+import (
+	"context"
+
+	G "github.com/IBM/fp-go/ioeither/generic"
+)
+
+// WithContext makes a computation honor the cancellation of a [context.Context]. If the context is already done
+// the computation is not started, otherwise the cancellation races against the completion of the computation.
+// Since an [IOEither] cannot be interrupted, a computation that loses the race keeps running in a goroutine until
+// it completes and its result is discarded.
+func WithContext[E, A any](ctx context.Context, onCancel func(error) E) func(IOEither[E, A]) IOEither[E, A] {
+	return G.WithContext[IOEither[E, A]](ctx, onCancel)
+}
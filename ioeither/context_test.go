@@ -0,0 +1,63 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioeither
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	E "github.com/IBM/fp-go/either"
+	F "github.com/IBM/fp-go/function"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	executed := false
+	ma := func() E.Either[string, int] {
+		executed = true
+		return E.Of[string](1)
+	}
+
+	res := WithContext[string, int](ctx, func(err error) string { return err.Error() })(ma)()
+
+	assert.Equal(t, E.Left[int]("context canceled"), res)
+	// the computation has not been started
+	assert.False(t, executed)
+}
+
+func TestWithContextMidFlight(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	slow := F.Pipe1(
+		Of[string](1),
+		Delay[string, int](time.Second),
+	)
+
+	res := WithContext[string, int](ctx, func(err error) string { return err.Error() })(slow)()
+
+	assert.Equal(t, E.Left[int]("context deadline exceeded"), res)
+}
+
+func TestWithContextCompleted(t *testing.T) {
+	res := WithContext[string, int](context.Background(), func(err error) string { return err.Error() })(Of[string](1))()
+
+	assert.Equal(t, E.Of[string](1), res)
+}
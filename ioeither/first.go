@@ -0,0 +1,40 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioeither
+
+import (
+	NEA "github.com/IBM/fp-go/array/nonempty"
+	G "github.com/IBM/fp-go/ioeither/generic"
+	S "github.com/IBM/fp-go/semigroup"
+)
+
+// FirstSuccessOfSeq executes the computations one after the other and returns the first success. If all computations
+// fail, their errors are combined in order using the [S.Semigroup].
+func FirstSuccessOfSeq[E, A any](sg S.Semigroup[E]) func(NEA.NonEmptyArray[IOEither[E, A]]) IOEither[E, A] {
+	return G.FirstSuccessOfSeq[IOEither[E, A]](sg)
+}
+
+// FirstSuccessOfPar executes the computations concurrently and returns the first success to complete. If all computations
+// fail, their errors are combined in the order of the array using the [S.Semigroup].
+func FirstSuccessOfPar[E, A any](sg S.Semigroup[E]) func(NEA.NonEmptyArray[IOEither[E, A]]) IOEither[E, A] {
+	return G.FirstSuccessOfPar[IOEither[E, A]](sg)
+}
+
+// FirstSuccessOf executes the computations one after the other and returns the first success. If all computations
+// fail, their errors are combined in order using the [S.Semigroup].
+func FirstSuccessOf[E, A any](sg S.Semigroup[E]) func(NEA.NonEmptyArray[IOEither[E, A]]) IOEither[E, A] {
+	return G.FirstSuccessOf[IOEither[E, A]](sg)
+}
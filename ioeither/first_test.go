@@ -0,0 +1,80 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioeither
+
+import (
+	"testing"
+	"time"
+
+	NEA "github.com/IBM/fp-go/array/nonempty"
+	E "github.com/IBM/fp-go/either"
+	F "github.com/IBM/fp-go/function"
+	S "github.com/IBM/fp-go/semigroup"
+	STR "github.com/IBM/fp-go/string"
+	"github.com/stretchr/testify/assert"
+)
+
+var errorsSemigroup = S.Intercalate("; ")(STR.Semigroup())
+
+func TestFirstSuccessOfSeq(t *testing.T) {
+	executed := 0
+	track := func(ma IOEither[string, int]) IOEither[string, int] {
+		return func() E.Either[string, int] {
+			executed++
+			return ma()
+		}
+	}
+
+	first := FirstSuccessOfSeq[string, int](errorsSemigroup)
+
+	res := first(NEA.From(
+		track(Left[int]("a")),
+		track(Right[string](1)),
+		track(Right[string](2)),
+	))()
+
+	assert.Equal(t, E.Right[string](1), res)
+	// stops after the first success
+	assert.Equal(t, 2, executed)
+
+	// all fail
+	assert.Equal(t, E.Left[int]("a; b; c"), first(NEA.From(
+		Left[int]("a"),
+		Left[int]("b"),
+		Left[int]("c"),
+	))())
+}
+
+func TestFirstSuccessOfPar(t *testing.T) {
+	first := FirstSuccessOfPar[string, int](errorsSemigroup)
+
+	slowFailure := F.Pipe1(
+		Left[int]("a"),
+		Delay[string, int](100*time.Millisecond),
+	)
+
+	assert.Equal(t, E.Right[string](2), first(NEA.From(
+		slowFailure,
+		Right[string](2),
+	))())
+
+	// all fail, errors are combined in the order of the array
+	assert.Equal(t, E.Left[int]("a; b; c"), first(NEA.From(
+		slowFailure,
+		Left[int]("b"),
+		Left[int]("c"),
+	))())
+}
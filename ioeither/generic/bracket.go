@@ -17,6 +17,7 @@ package generic
 
 import (
 	ET "github.com/IBM/fp-go/either"
+	RA "github.com/IBM/fp-go/internal/array"
 	G "github.com/IBM/fp-go/internal/bracket"
 	I "github.com/IBM/fp-go/io/generic"
 )
@@ -44,3 +45,34 @@ func Bracket[
 		release,
 	)
 }
+
+// BracketMany acquires the resources in order, passes them to the body action and releases them in reverse order. If an
+// acquisition fails, the resources that have already been acquired are released.
+func BracketMany[
+	GAS ~[]GA,
+	GA ~func() ET.Either[E, A],
+	GB ~func() ET.Either[E, B],
+	GANY ~func() ET.Either[E, ANY],
+	E, A, B, ANY any](
+
+	acquire GAS,
+	use func([]A) GB,
+	release func(A) GANY,
+) GB {
+	var bracket func(int, []A) GB
+	bracket = func(idx int, as []A) GB {
+		if idx == len(acquire) {
+			return use(as)
+		}
+		return Bracket(
+			acquire[idx],
+			func(a A) GB {
+				return bracket(idx+1, RA.Push(as, a))
+			},
+			func(a A, _ ET.Either[E, B]) GANY {
+				return release(a)
+			},
+		)
+	}
+	return bracket(0, RA.Empty[[]A]())
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	NEA "github.com/IBM/fp-go/array/nonempty"
+	ET "github.com/IBM/fp-go/either"
+	F "github.com/IBM/fp-go/function"
+	RA "github.com/IBM/fp-go/internal/array"
+	S "github.com/IBM/fp-go/semigroup"
+)
+
+// orElseAccumulate executes the second computation only if the first one fails and combines the errors if both fail
+func orElseAccumulate[GA ~func() ET.Either[E, A], E, A any](sg S.Semigroup[E]) func(GA, GA) GA {
+	return func(first, second GA) GA {
+		return MakeIO(func() ET.Either[E, A] {
+			return ET.MonadFold(first(), func(e E) ET.Either[E, A] {
+				return ET.MonadMapLeft(second(), F.Bind1st(sg.Concat, e))
+			}, ET.Right[E, A])
+		})
+	}
+}
+
+// FirstSuccessOfSeq executes the computations one after the other and returns the first success. If all computations
+// fail, their errors are combined in order using the [S.Semigroup].
+func FirstSuccessOfSeq[GA ~func() ET.Either[E, A], E, A any](sg S.Semigroup[E]) func(NEA.NonEmptyArray[GA]) GA {
+	concat := orElseAccumulate[GA](sg)
+	return func(as NEA.NonEmptyArray[GA]) GA {
+		return RA.Reduce(NEA.Tail(as), concat, NEA.Head(as))
+	}
+}
+
+// FirstSuccessOfPar executes the computations concurrently and returns the first success to complete. If all computations
+// fail, their errors are combined in the order of the array using the [S.Semigroup].
+func FirstSuccessOfPar[GA ~func() ET.Either[E, A], E, A any](sg S.Semigroup[E]) func(NEA.NonEmptyArray[GA]) GA {
+	type result struct {
+		idx int
+		res ET.Either[E, A]
+	}
+	return func(as NEA.NonEmptyArray[GA]) GA {
+		return MakeIO(func() ET.Either[E, A] {
+			// buffered so the remaining computations do not block after the first success
+			c := make(chan result, len(as))
+			for i, a := range as {
+				go func(i int, a GA) {
+					c <- result{i, a()}
+				}(i, a)
+			}
+			errs := make(NEA.NonEmptyArray[E], len(as))
+			for range as {
+				r := <-c
+				if ET.IsRight(r.res) {
+					return r.res
+				}
+				_, errs[r.idx] = ET.Unwrap(r.res)
+			}
+			return ET.Left[A](NEA.Fold(sg)(errs))
+		})
+	}
+}
+
+// FirstSuccessOf executes the computations one after the other and returns the first success. If all computations
+// fail, their errors are combined in order using the [S.Semigroup].
+func FirstSuccessOf[GA ~func() ET.Either[E, A], E, A any](sg S.Semigroup[E]) func(NEA.NonEmptyArray[GA]) GA {
+	return FirstSuccessOfSeq[GA](sg)
+}
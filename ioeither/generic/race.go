@@ -0,0 +1,36 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	ET "github.com/IBM/fp-go/either"
+)
+
+// Race runs all computations concurrently and returns the result of the first one to complete, no matter if
+// that result is a success or a failure. Since an [IOEither] cannot be cancelled, the remaining computations keep
+// running in the background and their results are discarded. Race on an empty array never completes.
+func Race[GA ~func() ET.Either[E, A], GAS ~[]GA, E, A any](as GAS) GA {
+	return MakeIO(func() ET.Either[E, A] {
+		// buffered so the losers do not block after the result has been consumed
+		c := make(chan ET.Either[E, A], len(as))
+		for _, a := range as {
+			go func(a GA) {
+				c <- a()
+			}(a)
+		}
+		return <-c
+	})
+}
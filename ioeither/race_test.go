@@ -0,0 +1,41 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioeither
+
+import (
+	"testing"
+	"time"
+
+	E "github.com/IBM/fp-go/either"
+	F "github.com/IBM/fp-go/function"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRace(t *testing.T) {
+	slow := F.Pipe1(
+		Of[string]("slow"),
+		Delay[string, string](time.Second),
+	)
+	fast := F.Pipe1(
+		Of[string]("fast"),
+		Delay[string, string](10*time.Millisecond),
+	)
+	failFast := Left[string]("failed")
+
+	assert.Equal(t, E.Of[string]("fast"), Race([]IOEither[string, string]{slow, fast})())
+	// a failure also wins the race
+	assert.Equal(t, E.Left[string]("failed"), Race([]IOEither[string, string]{slow, fast, failFast})())
+}
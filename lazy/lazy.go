@@ -18,6 +18,7 @@ package lazy
 import (
 	"time"
 
+	IO "github.com/IBM/fp-go/io"
 	G "github.com/IBM/fp-go/io/generic"
 )
 
@@ -36,6 +37,17 @@ func FromLazy[A any](a Lazy[A]) Lazy[A] {
 	return G.FromIO(a)
 }
 
+// FromIO converts an [IO.IO] into a [Lazy]. Both share the same representation, the conversion documents that the
+// computation is considered to be free of side effects
+func FromIO[A any](ma IO.IO[A]) Lazy[A] {
+	return Lazy[A](ma)
+}
+
+// ToIO converts a [Lazy] into an [IO.IO]. Both share the same representation, so this is a pure type conversion
+func ToIO[A any](ma Lazy[A]) IO.IO[A] {
+	return IO.IO[A](ma)
+}
+
 // FromImpure converts a side effect without a return value into a side effect that returns any
 func FromImpure(f func()) Lazy[any] {
 	return G.FromImpure[Lazy[any]](f)
@@ -84,10 +96,20 @@ func Flatten[A any](mma Lazy[Lazy[A]]) Lazy[A] {
 }
 
 // Memoize computes the value of the provided [Lazy] monad lazily but exactly once
+//
+// It is safe for concurrent callers, the computation runs exactly once and all callers observe the same result.
 func Memoize[A any](ma Lazy[A]) Lazy[A] {
 	return G.Memoize(ma)
 }
 
+// MemoizeWithTTL computes the value of the provided [Lazy] monad lazily and caches it until the time-to-live has
+// elapsed since the last evaluation. It is safe for concurrent callers, at most one evaluation happens at a time.
+//
+// Because [Lazy] is synchronous, the first caller after the expiry pays the cost of the recomputation.
+func MemoizeWithTTL[A any](ttl time.Duration) func(Lazy[A]) Lazy[A] {
+	return G.MemoizeWithTTL[Lazy[A]](ttl)
+}
+
 // MonadChainFirst composes computations in sequence, using the return value of one computation to determine the next computation and
 // keeping only the result of the first.
 func MonadChainFirst[A, B any](fa Lazy[A], f func(A) Lazy[B]) Lazy[A] {
@@ -100,6 +122,14 @@ func ChainFirst[A, B any](f func(A) Lazy[B]) func(Lazy[A]) Lazy[A] {
 	return G.ChainFirst[Lazy[A]](f)
 }
 
+// Tap runs the side effect on the computed value and returns the original value
+func Tap[A any](f func(A)) func(Lazy[A]) Lazy[A] {
+	return Map(func(a A) A {
+		f(a)
+		return a
+	})
+}
+
 // MonadApFirst combines two effectful actions, keeping only the result of the first.
 func MonadApFirst[A, B any](first Lazy[A], second Lazy[B]) Lazy[A] {
 	return G.MonadApFirst[Lazy[A], Lazy[B], Lazy[func(B) A]](first, second)
@@ -130,6 +160,16 @@ func ChainTo[A, B any](fb Lazy[B]) func(Lazy[A]) Lazy[B] {
 	return G.ChainTo[Lazy[A]](fb)
 }
 
+// ZipWith combines two [Lazy] values using a function. The first value is evaluated before the second one.
+func ZipWith[A, B, C any](f func(A, B) C) func(Lazy[A], Lazy[B]) Lazy[C] {
+	return func(fa Lazy[A], fb Lazy[B]) Lazy[C] {
+		return MakeLazy(func() C {
+			a := fa()
+			return f(a, fb())
+		})
+	}
+}
+
 // Now returns the current timestamp
 var Now = G.Now[Lazy[time.Time]]()
 
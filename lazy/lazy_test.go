@@ -16,11 +16,18 @@
 package lazy
 
 import (
+	"fmt"
 	"math/rand"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	A "github.com/IBM/fp-go/array"
 	F "github.com/IBM/fp-go/function"
 	"github.com/IBM/fp-go/internal/utils"
+	IO "github.com/IBM/fp-go/io"
+	S "github.com/IBM/fp-go/string"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -52,6 +59,31 @@ func TestMemoize(t *testing.T) {
 	assert.Equal(t, value1, value2)
 }
 
+func TestMemoizeConcurrent(t *testing.T) {
+	var count atomic.Int64
+
+	data := Memoize(MakeLazy(func() int64 {
+		return count.Add(1)
+	}))
+
+	var wg sync.WaitGroup
+	results := make([]int64, 100)
+
+	for i := range results {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			results[idx] = data()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		assert.Equal(t, int64(1), result)
+	}
+	assert.Equal(t, int64(1), count.Load())
+}
+
 func TestApFirst(t *testing.T) {
 
 	x := F.Pipe1(
@@ -71,3 +103,104 @@ func TestApSecond(t *testing.T) {
 
 	assert.Equal(t, "b", x())
 }
+
+func TestMemoizeWithTTL(t *testing.T) {
+	var count int
+
+	data := F.Pipe1(
+		MakeLazy(func() int {
+			count++
+			return count
+		}),
+		MemoizeWithTTL[int](50*time.Millisecond),
+	)
+
+	// cached within the ttl
+	assert.Equal(t, 1, data())
+	assert.Equal(t, 1, data())
+	assert.Equal(t, 1, count)
+
+	// recomputed after the ttl
+	time.Sleep(100 * time.Millisecond)
+
+	assert.Equal(t, 2, data())
+	assert.Equal(t, 2, data())
+	assert.Equal(t, 2, count)
+}
+
+func TestTraverseRecordOrdered(t *testing.T) {
+	var order []string
+
+	record := map[string]int{"c": 3, "a": 1, "d": 4, "b": 2}
+
+	res := F.Pipe1(
+		record,
+		TraverseRecordOrdered[string, int, int](S.Ord)(func(n int) Lazy[int] {
+			return MakeLazy(func() int {
+				order = append(order, fmt.Sprintf("%d", n))
+				return utils.Double(n)
+			})
+		}),
+	)
+
+	// nothing has been evaluated, yet
+	assert.Empty(t, order)
+
+	assert.Equal(t, map[string]int{"a": 2, "b": 4, "c": 6, "d": 8}, res())
+	assert.Equal(t, []string{"1", "2", "3", "4"}, order)
+}
+
+func TestFromIOToIO(t *testing.T) {
+	var count int
+	ma := FromIO(IO.MakeIO(func() int {
+		count++
+		return count
+	}))
+	// the conversion does not evaluate the computation
+	assert.Equal(t, 0, count)
+	assert.Equal(t, 1, ToIO(ma)())
+}
+
+func TestTap(t *testing.T) {
+	var seen []int
+	ma := F.Pipe1(
+		Of(1),
+		Tap(func(n int) {
+			seen = append(seen, n)
+		}),
+	)
+	// nothing happens before evaluation
+	assert.Empty(t, seen)
+	assert.Equal(t, 1, ma())
+	assert.Equal(t, []int{1}, seen)
+}
+
+// logged returns a [Lazy] that records its evaluation in the log
+func logged(log *[]string, value string) Lazy[string] {
+	return MakeLazy(func() string {
+		*log = append(*log, value)
+		return value
+	})
+}
+
+func TestSequenceArray(t *testing.T) {
+	res := SequenceArray(A.From(Of("a"), Of("b"), Of("c")))
+
+	assert.Equal(t, []string{"a", "b", "c"}, res())
+}
+
+func TestSequenceArrayReverse(t *testing.T) {
+	var log []string
+	res := SequenceArrayReverse(A.From(logged(&log, "a"), logged(&log, "b"), logged(&log, "c")))
+
+	assert.Equal(t, []string{"a", "b", "c"}, res())
+	assert.Equal(t, []string{"c", "b", "a"}, log)
+}
+
+func TestZipWith(t *testing.T) {
+	var log []string
+	res := ZipWith(S.Monoid.Concat)(logged(&log, "a"), logged(&log, "b"))
+
+	assert.Equal(t, "ab", res())
+	assert.Equal(t, []string{"a", "b"}, log)
+}
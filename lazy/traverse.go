@@ -16,30 +16,46 @@
 package lazy
 
 import (
+	AR "github.com/IBM/fp-go/array"
+	F "github.com/IBM/fp-go/function"
 	G "github.com/IBM/fp-go/io/generic"
+	"github.com/IBM/fp-go/ord"
+	R "github.com/IBM/fp-go/record"
+	T "github.com/IBM/fp-go/tuple"
 )
 
 func MonadTraverseArray[A, B any](tas []A, f func(A) Lazy[B]) Lazy[[]B] {
 	return G.MonadTraverseArray[Lazy[B], Lazy[[]B]](tas, f)
 }
 
-// TraverseArray applies a function returning an [IO] to all elements in an array and the
-// transforms this into an [IO] of that array
+// TraverseArray applies a function returning a [Lazy] to all elements in an array and the
+// transforms this into a [Lazy] of that array. The computations may be evaluated concurrently, so their order is not defined.
 func TraverseArray[A, B any](f func(A) Lazy[B]) func([]A) Lazy[[]B] {
 	return G.TraverseArray[Lazy[B], Lazy[[]B], []A](f)
 }
 
-// TraverseArrayWithIndex applies a function returning an [IO] to all elements in an array and the
-// transforms this into an [IO] of that array
+// TraverseArrayWithIndex applies a function returning a [Lazy] to all elements in an array and the
+// transforms this into a [Lazy] of that array. The computations may be evaluated concurrently, so their order is not defined.
 func TraverseArrayWithIndex[A, B any](f func(int, A) Lazy[B]) func([]A) Lazy[[]B] {
 	return G.TraverseArrayWithIndex[Lazy[B], Lazy[[]B], []A](f)
 }
 
-// SequenceArray converts an array of [IO] to an [IO] of an array
+// SequenceArray converts an array of [Lazy] to a [Lazy] of an array. The computations may be evaluated concurrently,
+// so their order is not defined.
 func SequenceArray[A any](tas []Lazy[A]) Lazy[[]A] {
 	return G.SequenceArray[Lazy[A], Lazy[[]A]](tas)
 }
 
+// SequenceArrayReverse converts an array of [Lazy] to a [Lazy] of an array. In contrast to [SequenceArray] the computations
+// are evaluated sequentially from right to left but the result keeps the order of the input array.
+func SequenceArrayReverse[A any](tas []Lazy[A]) Lazy[[]A] {
+	return F.Pipe2(
+		AR.Reverse(tas),
+		G.SequenceArraySeq[Lazy[A], Lazy[[]A], []A, []Lazy[A]],
+		Map(AR.Reverse[A]),
+	)
+}
+
 func MonadTraverseRecord[K comparable, A, B any](tas map[K]A, f func(A) Lazy[B]) Lazy[map[K]B] {
 	return G.MonadTraverseRecord[Lazy[map[K]B]](tas, f)
 }
@@ -60,3 +76,22 @@ func TraverseRecordWithIndex[K comparable, A, B any](f func(K, A) Lazy[B]) func(
 func SequenceRecord[K comparable, A any](tas map[K]Lazy[A]) Lazy[map[K]A] {
 	return G.SequenceRecord[Lazy[A], Lazy[map[K]A]](tas)
 }
+
+// TraverseRecordOrdered applies a function returning a [Lazy] to all elements in a record and the
+// transforms this into a [Lazy] of that record. In contrast to [TraverseRecord] the computations are
+// evaluated sequentially in the order of the keys given by the [ord.Ord]
+func TraverseRecordOrdered[K comparable, A, B any](o ord.Ord[K]) func(func(A) Lazy[B]) func(map[K]A) Lazy[map[K]B] {
+	entries := R.CollectOrd[A, T.Tuple2[K, A]](o)(T.MakeTuple2[K, A])
+	return func(f func(A) Lazy[B]) func(map[K]A) Lazy[map[K]B] {
+		return F.Flow3(
+			entries,
+			G.TraverseArraySeq[Lazy[T.Tuple2[K, B]], Lazy[[]T.Tuple2[K, B]], []T.Tuple2[K, A]](func(e T.Tuple2[K, A]) Lazy[T.Tuple2[K, B]] {
+				return F.Pipe1(
+					f(e.F2),
+					Map(F.Bind1st(T.MakeTuple2[K, B], e.F1)),
+				)
+			}),
+			Map(R.FromEntries[K, B]),
+		)
+	}
+}
@@ -47,6 +47,11 @@ func Reverse[A any](m Monoid[A]) Monoid[A] {
 	return MakeMonoid(S.Reverse[A](m).Concat, m.Empty())
 }
 
+// Dual is an alias for [Reverse], it returns the dual of a `Monoid`, obtained by swapping the arguments of `Concat`.
+func Dual[A any](m Monoid[A]) Monoid[A] {
+	return Reverse(m)
+}
+
 func ToSemigroup[A any](m Monoid[A]) S.Semigroup[A] {
 	return S.Semigroup[A](m)
 }
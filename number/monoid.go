@@ -36,3 +36,13 @@ func MonoidProduct[A Number]() M.Monoid[A] {
 		1,
 	)
 }
+
+// Sum adds all elements of an array, returning zero for an empty array
+func Sum[A Number](as []A) A {
+	return M.ConcatAll(MonoidSum[A]())(as)
+}
+
+// Product multiplies all elements of an array, returning one for an empty array
+func Product[A Number](as []A) A {
+	return M.ConcatAll(MonoidProduct[A]())(as)
+}
@@ -19,8 +19,20 @@ import (
 	"testing"
 
 	M "github.com/IBM/fp-go/monoid/testing"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestMonoidSum(t *testing.T) {
 	M.AssertLaws(t, MonoidSum[int]())([]int{0, 1, 1000, -1})
 }
+
+func TestSum(t *testing.T) {
+	assert.Equal(t, 6, Sum([]int{1, 2, 3}))
+	assert.Equal(t, 0, Sum([]int{}))
+	assert.Equal(t, 3.5, Sum([]float64{1.5, 2}))
+}
+
+func TestProduct(t *testing.T) {
+	assert.Equal(t, 24, Product([]int{2, 3, 4}))
+	assert.Equal(t, 1, Product([]int{}))
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package number
+
+import (
+	C "github.com/IBM/fp-go/constraints"
+	O "github.com/IBM/fp-go/ord"
+	P "github.com/IBM/fp-go/predicate"
+)
+
+// Clamp restricts a number to the range from low to high (both inclusive)
+func Clamp[A C.Ordered](low, high A) func(A) A {
+	return O.Clamp(O.FromStrictCompare[A]())(low, high)
+}
+
+// InRange tests whether a number is in the range from low to high (both inclusive)
+func InRange[A C.Ordered](low, high A) func(A) bool {
+	o := O.FromStrictCompare[A]()
+	return P.And(O.Leq(o)(high))(O.Geq(o)(low))
+}
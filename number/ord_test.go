@@ -0,0 +1,41 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package number
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClamp(t *testing.T) {
+	clamp := Clamp(1, 3)
+
+	assert.Equal(t, 1, clamp(0))
+	assert.Equal(t, 2, clamp(2))
+	assert.Equal(t, 3, clamp(4))
+	assert.Equal(t, 0.5, Clamp(0.5, 1.5)(-1.0))
+}
+
+func TestInRange(t *testing.T) {
+	inRange := InRange(1, 3)
+
+	assert.False(t, inRange(0))
+	assert.True(t, inRange(1))
+	assert.True(t, inRange(2))
+	assert.True(t, inRange(3))
+	assert.False(t, inRange(4))
+}
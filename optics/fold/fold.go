@@ -0,0 +1,100 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Fold is a read-only optic that focuses on zero or more values inside a structure. Unlike a `Traversal` a `Fold`
+// cannot be used to modify the structure.
+package fold
+
+import (
+	AR "github.com/IBM/fp-go/array"
+	C "github.com/IBM/fp-go/constant"
+	F "github.com/IBM/fp-go/function"
+	M "github.com/IBM/fp-go/monoid"
+	L "github.com/IBM/fp-go/optics/lens"
+	P "github.com/IBM/fp-go/optics/prism"
+	TG "github.com/IBM/fp-go/optics/traversal/generic"
+	O "github.com/IBM/fp-go/option"
+)
+
+type (
+	// Fold is a read-only reference to zero or more subparts of a data type
+	Fold[S, A any] struct {
+		GetAll func(s S) []A
+	}
+)
+
+// MakeFold creates a Fold based on a function that returns all focused values
+func MakeFold[S, A any](getAll func(S) []A) Fold[S, A] {
+	return Fold[S, A]{GetAll: getAll}
+}
+
+// Id returns a fold that focuses on the structure itself
+func Id[S any]() Fold[S, S] {
+	return MakeFold(AR.Of[S])
+}
+
+// Compose combines two folds and allows to narrow down the focus to a sub-fold
+func Compose[S, A, B any](ab Fold[A, B]) func(Fold[S, A]) Fold[S, B] {
+	return func(sa Fold[S, A]) Fold[S, B] {
+		return MakeFold(F.Flow2(
+			sa.GetAll,
+			AR.Chain(ab.GetAll),
+		))
+	}
+}
+
+// FoldMap maps each focused value to a [M.Monoid] and combines the results
+func FoldMap[A, S, B any](m M.Monoid[B]) func(func(A) B) func(Fold[S, A]) func(S) B {
+	return func(f func(A) B) func(Fold[S, A]) func(S) B {
+		fm := AR.FoldMap[A](m)(f)
+		return func(sa Fold[S, A]) func(S) B {
+			return F.Flow2(sa.GetAll, fm)
+		}
+	}
+}
+
+// GetAll gets all the focused values of a fold
+func GetAll[S, A any](s S) func(Fold[S, A]) []A {
+	return func(sa Fold[S, A]) []A {
+		return sa.GetAll(s)
+	}
+}
+
+// Preview returns the first focused value of a fold or [O.None] if the fold does not focus on any value
+func Preview[S, A any](s S) func(Fold[S, A]) O.Option[A] {
+	return func(sa Fold[S, A]) O.Option[A] {
+		return AR.Head(sa.GetAll(s))
+	}
+}
+
+// FromLens converts a [L.Lens] into a fold that focuses on exactly one value
+func FromLens[S, A any](sa L.Lens[S, A]) Fold[S, A] {
+	return MakeFold(F.Flow2(sa.Get, AR.Of[A]))
+}
+
+// FromPrism converts a [P.Prism] into a fold that focuses on zero or one value
+func FromPrism[S, A any](sa P.Prism[S, A]) Fold[S, A] {
+	return MakeFold(F.Flow2(
+		sa.GetOption,
+		O.Fold(AR.Empty[A], AR.Of[A]),
+	))
+}
+
+// FromTraversal converts a traversal for the const monad into a fold
+func FromTraversal[S, A any](sa TG.Traversal[S, A, C.Const[[]A, S], C.Const[[]A, A]]) Fold[S, A] {
+	return MakeFold(func(s S) []A {
+		return TG.GetAll[[]A](s)(sa)
+	})
+}
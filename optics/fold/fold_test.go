@@ -0,0 +1,76 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fold
+
+import (
+	"testing"
+
+	AR "github.com/IBM/fp-go/array"
+	E "github.com/IBM/fp-go/either"
+	F "github.com/IBM/fp-go/function"
+	N "github.com/IBM/fp-go/number"
+	L "github.com/IBM/fp-go/optics/lens"
+	P "github.com/IBM/fp-go/optics/prism"
+	AT "github.com/IBM/fp-go/optics/traversal/array/const"
+	O "github.com/IBM/fp-go/option"
+	"github.com/stretchr/testify/assert"
+)
+
+type Order struct {
+	items []int
+}
+
+var (
+	items = L.MakeLens(func(o Order) []int { return o.items }, func(o Order, items []int) Order {
+		o.items = items
+		return o
+	})
+
+	allItems = F.Pipe1(
+		FromLens(items),
+		Compose[Order](FromTraversal(AT.FromArray[[]int, int](AR.Monoid[int]()))),
+	)
+)
+
+func TestGetAll(t *testing.T) {
+	assert.Equal(t, AR.From(1, 2, 3), GetAll[Order, int](Order{AR.From(1, 2, 3)})(allItems))
+	assert.Empty(t, GetAll[Order, int](Order{})(allItems))
+}
+
+func TestFoldMap(t *testing.T) {
+	total := FoldMap[int, Order](N.MonoidSum[int]())(F.Identity[int])(allItems)
+
+	assert.Equal(t, 6, total(Order{AR.From(1, 2, 3)}))
+	assert.Equal(t, 0, total(Order{}))
+}
+
+func TestPreview(t *testing.T) {
+	assert.Equal(t, O.Some(1), Preview[Order, int](Order{AR.From(1, 2, 3)})(allItems))
+	// an empty fold previews to none
+	assert.Equal(t, O.None[int](), Preview[Order, int](Order{})(allItems))
+	assert.Equal(t, O.None[int](), Preview[int, int](1)(MakeFold(F.Constant1[int](AR.Empty[int]()))))
+}
+
+func TestFromPrism(t *testing.T) {
+	right := FromPrism(P.MakePrism(E.ToOption[string, int], E.Of[string, int]))
+
+	assert.Equal(t, AR.From(1), GetAll[E.Either[string, int], int](E.Of[string](1))(right))
+	assert.Equal(t, O.None[int](), Preview[E.Either[string, int], int](E.Left[int]("a"))(right))
+}
+
+func TestId(t *testing.T) {
+	assert.Equal(t, O.Some(1), Preview[int, int](1)(Id[int]()))
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iso
+
+import (
+	F "github.com/IBM/fp-go/function"
+	T "github.com/IBM/fp-go/tuple"
+)
+
+// Curry2 returns an iso between a function with two parameters and its curried form
+func Curry2[A, B, R any]() Iso[func(A, B) R, func(A) func(B) R] {
+	return MakeIso(
+		F.Curry2[func(A, B) R],
+		F.Uncurry2[func(A) func(B) R],
+	)
+}
+
+// Curry3 returns an iso between a function with three parameters and its curried form
+func Curry3[A, B, C, R any]() Iso[func(A, B, C) R, func(A) func(B) func(C) R] {
+	return MakeIso(
+		F.Curry3[func(A, B, C) R],
+		F.Uncurry3[func(A) func(B) func(C) R],
+	)
+}
+
+// Tupled2 returns an iso between a function with two parameters and a function accepting a [T.Tuple2]
+func Tupled2[A, B, R any]() Iso[func(A, B) R, func(T.Tuple2[A, B]) R] {
+	return MakeIso(
+		T.Tupled2[func(A, B) R],
+		T.Untupled2[func(T.Tuple2[A, B]) R],
+	)
+}
+
+// Tupled3 returns an iso between a function with three parameters and a function accepting a [T.Tuple3]
+func Tupled3[A, B, C, R any]() Iso[func(A, B, C) R, func(T.Tuple3[A, B, C]) R] {
+	return MakeIso(
+		T.Tupled3[func(A, B, C) R],
+		T.Untupled3[func(T.Tuple3[A, B, C]) R],
+	)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iso
+
+import (
+	"fmt"
+	"testing"
+
+	T "github.com/IBM/fp-go/tuple"
+	"github.com/stretchr/testify/assert"
+)
+
+func format(s string, n int) string {
+	return fmt.Sprintf("%s-%d", s, n)
+}
+
+func format3(s string, n int, b bool) string {
+	return fmt.Sprintf("%s-%d-%t", s, n, b)
+}
+
+func TestCurry2(t *testing.T) {
+	curry := Curry2[string, int, string]()
+
+	curried := curry.Get(format)
+	assert.Equal(t, "a-1", curried("a")(1))
+
+	// round trip
+	uncurried := curry.ReverseGet(curried)
+	assert.Equal(t, "a-1", uncurried("a", 1))
+	assert.Equal(t, "b-2", curry.Get(curry.ReverseGet(curried))("b")(2))
+}
+
+func TestCurry3(t *testing.T) {
+	curry := Curry3[string, int, bool, string]()
+
+	curried := curry.Get(format3)
+	assert.Equal(t, "a-1-true", curried("a")(1)(true))
+	assert.Equal(t, "a-1-true", curry.ReverseGet(curried)("a", 1, true))
+}
+
+func TestTupled2(t *testing.T) {
+	tupled := Tupled2[string, int, string]()
+
+	f := tupled.Get(format)
+	assert.Equal(t, "a-1", f(T.MakeTuple2("a", 1)))
+
+	// round trip
+	assert.Equal(t, "a-1", tupled.ReverseGet(f)("a", 1))
+}
+
+func TestTupled3(t *testing.T) {
+	tupled := Tupled3[string, int, bool, string]()
+
+	f := tupled.Get(format3)
+	assert.Equal(t, "a-1-false", f(T.MakeTuple3("a", 1, false)))
+	assert.Equal(t, "a-1-false", tupled.ReverseGet(f)("a", 1, false))
+}
@@ -104,3 +104,22 @@ func IMap[S, A, B any](ab func(A) B, ba func(B) A) func(Iso[S, A]) Iso[S, B] {
 		return imap(sa, ab, ba)
 	}
 }
+
+// Compose3 combines three isos, the first iso is applied first
+func Compose3[S, A, B, C any](sa Iso[S, A], ab Iso[A, B], bc Iso[B, C]) Iso[S, C] {
+	return F.Pipe2(
+		sa,
+		Compose[S](ab),
+		Compose[S](bc),
+	)
+}
+
+// Compose4 combines four isos, the first iso is applied first
+func Compose4[S, A, B, C, D any](sa Iso[S, A], ab Iso[A, B], bc Iso[B, C], cd Iso[C, D]) Iso[S, D] {
+	return F.Pipe3(
+		sa,
+		Compose[S](ab),
+		Compose[S](bc),
+		Compose[S](cd),
+	)
+}
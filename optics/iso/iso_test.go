@@ -16,6 +16,8 @@
 package iso
 
 import (
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -77,3 +79,60 @@ func TestCompose(t *testing.T) {
 	assert.InDelta(t, 0.93, comp.Get(1500), 0.01)
 	assert.InDelta(t, 1609.34, comp.ReverseGet(1), 0.01)
 }
+
+type Celsius struct {
+	Value int
+}
+
+var (
+	negate = MakeIso(
+		func(n int) int { return -n },
+		func(n int) int { return -n },
+	)
+
+	add10 = MakeIso(
+		func(n int) int { return n + 10 },
+		func(n int) int { return n - 10 },
+	)
+
+	toCelsius = MakeIso(
+		func(n int) Celsius { return Celsius{n} },
+		func(c Celsius) int { return c.Value },
+	)
+
+	toLabel = MakeIso(
+		func(c Celsius) string { return strconv.Itoa(c.Value) + "C" },
+		func(s string) Celsius {
+			n, _ := strconv.Atoi(strings.TrimSuffix(s, "C"))
+			return Celsius{n}
+		},
+	)
+)
+
+func TestCompose3(t *testing.T) {
+	comp := Compose3(negate, add10, toCelsius)
+
+	assert.Equal(t, Celsius{7}, comp.Get(3))
+	assert.Equal(t, 3, comp.ReverseGet(Celsius{7}))
+
+	// round trip
+	for _, s := range []int{-5, 0, 3, 42} {
+		assert.Equal(t, s, comp.ReverseGet(comp.Get(s)))
+		assert.Equal(t, Celsius{s}, comp.Get(comp.ReverseGet(Celsius{s})))
+	}
+}
+
+func TestCompose4(t *testing.T) {
+	comp := Compose4(negate, add10, toCelsius, toLabel)
+
+	assert.Equal(t, "7C", comp.Get(3))
+	assert.Equal(t, 3, comp.ReverseGet("7C"))
+
+	// round trip
+	for _, s := range []int{-5, 0, 3, 42} {
+		assert.Equal(t, s, comp.ReverseGet(comp.Get(s)))
+	}
+	for _, a := range []string{"-5C", "0C", "12C"} {
+		assert.Equal(t, a, comp.Get(comp.ReverseGet(a)))
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package iso
+
+import (
+	E "github.com/IBM/fp-go/either"
+	F "github.com/IBM/fp-go/function"
+	J "github.com/IBM/fp-go/json"
+)
+
+// Json returns an `Iso` between a value of type `T` and its JSON representation. The `Iso` assumes
+// valid data, values that cannot be serialized are mapped to `nil` and data that cannot be parsed is
+// mapped to the zero value of `T`. Use [prism.Json] for a safe parse.
+//
+// The law `ReverseGet(Get(t)) = t` holds for all values that survive a JSON round trip. The opposite
+// direction does not hold in general, because the serialized form is not unique (e.g. whitespace or key ordering).
+func Json[T any]() Iso[T, []byte] {
+	return MakeIso(
+		F.Flow2(
+			J.Marshal[T],
+			E.GetOrElse(F.Constant1[error, []byte](nil)),
+		),
+		F.Flow2(
+			J.Unmarshal[T],
+			E.GetOrElse(func(error) T {
+				var zero T
+				return zero
+			}),
+		),
+	)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package iso
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type jsonSample struct {
+	Name  string `json:"name"`
+	Value int    `json:"value"`
+}
+
+func TestJson(t *testing.T) {
+	sa := Json[jsonSample]()
+
+	s := jsonSample{Name: "a", Value: 1}
+
+	assert.Equal(t, []byte(`{"name":"a","value":1}`), sa.Get(s))
+	assert.Equal(t, s, sa.ReverseGet(sa.Get(s)))
+	// invalid data maps to the zero value
+	assert.Equal(t, jsonSample{}, sa.ReverseGet([]byte("invalid")))
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package iso
+
+import (
+	F "github.com/IBM/fp-go/function"
+	P "github.com/IBM/fp-go/optics/prism"
+)
+
+// ComposePrism composes an `Iso` with a `Prism`. The result is a `Prism` because the conversion
+// of the `Prism` might fail
+func ComposePrism[S, A, B any](ab P.Prism[A, B]) func(Iso[S, A]) P.Prism[S, B] {
+	return func(sa Iso[S, A]) P.Prism[S, B] {
+		return P.MakePrism(
+			F.Flow2(sa.Get, ab.GetOption),
+			F.Flow2(ab.ReverseGet, sa.ReverseGet),
+		)
+	}
+}
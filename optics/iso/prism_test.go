@@ -0,0 +1,64 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package iso
+
+import (
+	"encoding/base64"
+	"strconv"
+	"testing"
+
+	F "github.com/IBM/fp-go/function"
+	P "github.com/IBM/fp-go/optics/prism"
+	O "github.com/IBM/fp-go/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposePrism(t *testing.T) {
+
+	// base64 encoding of bytes
+	base64Iso := MakeIso(
+		func(s string) []byte {
+			data, _ := base64.StdEncoding.DecodeString(s)
+			return data
+		},
+		base64.StdEncoding.EncodeToString,
+	)
+
+	// parsing of a number that might fail
+	intPrism := P.MakePrism(
+		func(data []byte) O.Option[int] {
+			return O.TryCatch(func() (int, error) {
+				return strconv.Atoi(string(data))
+			})
+		},
+		func(n int) []byte {
+			return []byte(strconv.Itoa(n))
+		},
+	)
+
+	sb := F.Pipe1(
+		base64Iso,
+		ComposePrism[string](intPrism),
+	)
+
+	assert.Equal(t, O.Of(42), sb.GetOption(base64.StdEncoding.EncodeToString([]byte("42"))))
+	assert.Equal(t, O.None[int](), sb.GetOption(base64.StdEncoding.EncodeToString([]byte("abc"))))
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("42")), sb.ReverseGet(42))
+
+	// getOption(reverseGet(b)) = Some(b)
+	for _, b := range []int{-1, 0, 1, 42} {
+		assert.Equal(t, O.Of(b), sb.GetOption(sb.ReverseGet(b)))
+	}
+}
@@ -17,6 +17,7 @@
 package lens
 
 import (
+	AR "github.com/IBM/fp-go/array/generic"
 	EM "github.com/IBM/fp-go/endomorphism"
 	F "github.com/IBM/fp-go/function"
 	O "github.com/IBM/fp-go/option"
@@ -39,6 +40,15 @@ func setCopy[SET ~func(*S, A) *S, S, A any](setter SET) func(s *S, a A) *S {
 	}
 }
 
+// setCopySlice wraps a setter for a slice property of a pointer into a setter that first creates a copy of
+// the pointer and of the new slice before modifying that copy
+func setCopySlice[SET ~func(*S, GA) *S, GA ~[]A, S, A any](setter SET) func(s *S, a GA) *S {
+	return func(s *S, a GA) *S {
+		cpy := *s
+		return setter(&cpy, AR.Copy(a))
+	}
+}
+
 // setCopyCurried wraps a setter for a pointer into a setter that first creates a copy before
 // modifying that copy
 func setCopyCurried[SET ~func(A) EM.Endomorphism[*S], S, A any](setter SET) func(a A) EM.Endomorphism[*S] {
@@ -73,6 +83,16 @@ func MakeLensRef[GET ~func(*S) A, SET func(*S, A) *S, S, A any](get GET, set SET
 	return MakeLens(get, setCopy(set))
 }
 
+// MakeLensRefDeep creates a [Lens] for a slice property based on a getter and a setter function. In addition to the
+// shallow copy of the structure performed by [MakeLensRef], the setter receives a shallow copy of the new slice, so
+// the result does not share an underlying array with the slice passed to `Set`. The setter must assign the slice
+// rather than writing into the existing one.
+//
+// Such a [Lens] assumes that property A of S always exists
+func MakeLensRefDeep[GET ~func(*S) GA, SET ~func(*S, GA) *S, GA ~[]A, S, A any](get GET, set SET) Lens[*S, GA] {
+	return MakeLens(get, setCopySlice(set))
+}
+
 // MakeLensRefCurried creates a [Lens] based on a getter and a setter function. The setter passed in does not have to create a shallow
 // copy, the implementation wraps the setter into one that copies the pointer before modifying it
 //
@@ -231,6 +251,57 @@ func Modify[S any, FCT ~func(A) A, A any](f FCT) func(Lens[S, A]) EM.Endomorphis
 	return EM.Curry3(modify[FCT, S, A])(f)
 }
 
+func modifyWhen[S, A any](pred func(A) bool, f func(A) A, sa Lens[S, A], s S) S {
+	a := sa.Get(s)
+	if pred(a) {
+		return sa.Set(f(a))(s)
+	}
+	return s
+}
+
+// ModifyWhen changes a property of a [Lens] by invoking a transformation function but only if the
+// current value of the property matches the predicate. Otherwise the method returns the original state
+func ModifyWhen[S, A any](pred func(A) bool, f func(A) A) func(Lens[S, A]) EM.Endomorphism[S] {
+	return func(sa Lens[S, A]) EM.Endomorphism[S] {
+		return F.Bind123of4(modifyWhen[S, A])(pred, f, sa)
+	}
+}
+
+func setWhen[S, A any](pred func(S) bool, a A, sa Lens[S, A], s S) S {
+	if pred(s) {
+		return sa.Set(a)(s)
+	}
+	return s
+}
+
+// SetWhen sets a property of a [Lens] but only if the whole structure matches the predicate. Otherwise the method
+// returns the original state. In contrast to [ModifyWhen] the predicate tests the structure and not the property
+func SetWhen[S, A any](pred func(S) bool, a A) func(Lens[S, A]) EM.Endomorphism[S] {
+	return func(sa Lens[S, A]) EM.Endomorphism[S] {
+		return F.Bind123of4(setWhen[S, A])(pred, a, sa)
+	}
+}
+
+// Modify2 changes two properties together. Both values are read from the original structure and the transformation
+// computes the new values jointly, which allows to maintain invariants that couple the properties. The new values are
+// written back via the setter of each [Lens], so the structure is copied once per lens, just like chaining the setters.
+func Modify2[S, A, B any](la Lens[S, A], lb Lens[S, B], f func(A, B) (A, B)) EM.Endomorphism[S] {
+	return func(s S) S {
+		a, b := f(la.Get(s), lb.Get(s))
+		return la.Set(a)(lb.Set(b)(s))
+	}
+}
+
+// Modify3 changes three properties together. All values are read from the original structure and the transformation
+// computes the new values jointly, which allows to maintain invariants that couple the properties. The new values are
+// written back via the setter of each [Lens], so the structure is copied once per lens, just like chaining the setters.
+func Modify3[S, A, B, C any](la Lens[S, A], lb Lens[S, B], lc Lens[S, C], f func(A, B, C) (A, B, C)) EM.Endomorphism[S] {
+	return func(s S) S {
+		a, b, c := f(la.Get(s), lb.Get(s), lc.Get(s))
+		return la.Set(a)(lb.Set(b)(lc.Set(c)(s)))
+	}
+}
+
 func IMap[E any, AB ~func(A) B, BA ~func(B) A, A, B any](ab AB, ba BA) func(Lens[E, A]) Lens[E, B] {
 	return func(ea Lens[E, A]) Lens[E, B] {
 		return Lens[E, B]{Get: F.Flow2(ea.Get, ab), Set: F.Flow2(ba, ea.Set)}
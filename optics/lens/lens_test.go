@@ -248,3 +248,130 @@ func TestComposeOptions(t *testing.T) {
 	assert.Equal(t, O.Some(&defaultValue1), lens.Get(OuterOpt{inner: &InnerOpt{Value: &defaultValue1, Foo: &defaultFoo1}}))
 	assert.Equal(t, outer1, Modify[OuterOpt](F.Identity[O.Option[*int]])(lens)(outer1))
 }
+
+func TestModifyWhen(t *testing.T) {
+
+	type Counter struct {
+		count int
+	}
+
+	count := MakeLens(func(c Counter) int { return c.count }, func(c Counter, count int) Counter {
+		c.count = count
+		return c
+	})
+
+	belowCap := func(n int) bool {
+		return n < 10
+	}
+	inc := func(n int) int {
+		return n + 1
+	}
+
+	incBelowCap := ModifyWhen[Counter](belowCap, inc)(count)
+
+	// matching branch
+	assert.Equal(t, Counter{6}, incBelowCap(Counter{5}))
+	// non-matching branch
+	assert.Equal(t, Counter{10}, incBelowCap(Counter{10}))
+}
+
+type Tags struct {
+	items []string
+}
+
+func (tags *Tags) GetItems() []string {
+	return tags.items
+}
+
+func (tags *Tags) SetItems(items []string) *Tags {
+	tags.items = items
+	return tags
+}
+
+func TestMakeLensRefDeep(t *testing.T) {
+	items := MakeLensRefDeep((*Tags).GetItems, (*Tags).SetItems)
+
+	original := &Tags{items: []string{"a", "b"}}
+	newItems := []string{"c", "d"}
+
+	updated := items.Set(newItems)(original)
+
+	assert.Equal(t, []string{"c", "d"}, items.Get(updated))
+	// the original slice is unchanged
+	assert.Equal(t, []string{"a", "b"}, items.Get(original))
+	// the result does not share the slice with the caller
+	newItems[0] = "x"
+	assert.Equal(t, []string{"c", "d"}, items.Get(updated))
+	// modifying the result does not touch the original
+	items.Get(updated)[1] = "y"
+	assert.Equal(t, []string{"a", "b"}, items.Get(original))
+}
+
+type Rect struct {
+	width  int
+	height int
+	depth  int
+}
+
+var (
+	rectWidth = MakeLens(func(r Rect) int { return r.width }, func(r Rect, w int) Rect {
+		r.width = w
+		return r
+	})
+	rectHeight = MakeLens(func(r Rect) int { return r.height }, func(r Rect, h int) Rect {
+		r.height = h
+		return r
+	})
+	rectDepth = MakeLens(func(r Rect) int { return r.depth }, func(r Rect, d int) Rect {
+		r.depth = d
+		return r
+	})
+)
+
+func TestModify2(t *testing.T) {
+	// change the width and keep the aspect ratio
+	resize := func(width int) func(Rect) Rect {
+		return Modify2(rectWidth, rectHeight, func(w, h int) (int, int) {
+			return width, h * width / w
+		})
+	}
+
+	r := Rect{width: 4, height: 3}
+	assert.Equal(t, Rect{width: 8, height: 6}, resize(8)(r))
+	assert.Equal(t, Rect{width: 12, height: 9}, resize(12)(r))
+	// the original is unchanged
+	assert.Equal(t, Rect{width: 4, height: 3}, r)
+}
+
+func TestModify3(t *testing.T) {
+	// rotate the dimensions
+	rotate := Modify3(rectWidth, rectHeight, rectDepth, func(w, h, d int) (int, int, int) {
+		return h, d, w
+	})
+
+	assert.Equal(t, Rect{width: 2, height: 3, depth: 1}, rotate(Rect{width: 1, height: 2, depth: 3}))
+}
+
+func TestSetWhen(t *testing.T) {
+
+	type Record struct {
+		status string
+		locked bool
+	}
+
+	status := MakeLens(func(r Record) string { return r.status }, func(r Record, s string) Record {
+		r.status = s
+		return r
+	})
+
+	notLocked := func(r Record) bool {
+		return !r.locked
+	}
+
+	publish := SetWhen(notLocked, "published")(status)
+
+	// matching branch
+	assert.Equal(t, Record{status: "published"}, publish(Record{status: "draft"}))
+	// non-matching branch
+	assert.Equal(t, Record{status: "draft", locked: true}, publish(Record{status: "draft", locked: true}))
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package array
+
+import (
+	OP "github.com/IBM/fp-go/optics/optional"
+	G "github.com/IBM/fp-go/optics/optional/array/generic"
+)
+
+// AtIndex returns a Optional that gets and sets elements of an array. Indexes out of bounds
+// yield a `None` when getting the value and leave the array unchanged when setting the value.
+func AtIndex[A any](idx int) OP.Optional[[]A, A] {
+	return G.AtIndex[[]A](idx)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package array
+
+import (
+	"testing"
+
+	AR "github.com/IBM/fp-go/array"
+	O "github.com/IBM/fp-go/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionalArray(t *testing.T) {
+	// sample array
+	as := AR.From("a", "b", "c")
+
+	opt1 := AtIndex[string](1)
+	opt3 := AtIndex[string](3)
+	optNeg := AtIndex[string](-1)
+
+	// check if we can get the index
+	assert.Equal(t, O.Of("b"), opt1.GetOption(as))
+	assert.Equal(t, O.None[string](), opt3.GetOption(as))
+	assert.Equal(t, O.None[string](), optNeg.GetOption(as))
+
+	// check if we can set a value
+	as1 := opt1.Set("x")(as)
+	assert.Equal(t, AR.From("a", "x", "c"), as1)
+	assert.Equal(t, AR.From("a", "b", "c"), as)
+
+	// out of bounds leaves the array unchanged
+	assert.Equal(t, as, opt3.Set("x")(as))
+	assert.Equal(t, as, optNeg.Set("x")(as))
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package generic
+
+import (
+	AR "github.com/IBM/fp-go/array/generic"
+	OP "github.com/IBM/fp-go/optics/optional"
+	O "github.com/IBM/fp-go/option"
+)
+
+func setter[GA ~[]A, A any](idx int) func(GA, A) GA {
+	return func(dst GA, value A) GA {
+		if idx < 0 || idx >= len(dst) {
+			return dst
+		}
+		cpy := make(GA, len(dst))
+		copy(cpy, dst)
+		cpy[idx] = value
+		return cpy
+	}
+}
+
+func getter[GA ~[]A, A any](idx int) func(GA) O.Option[A] {
+	return AR.Lookup[GA](idx)
+}
+
+// AtIndex returns a Optional that gets and sets elements of an array
+func AtIndex[GA ~[]A, A any](idx int) OP.Optional[GA, A] {
+	return OP.MakeOptional(getter[GA](idx), setter[GA](idx))
+}
@@ -18,10 +18,12 @@ package lens
 import (
 	"testing"
 
+	EQ "github.com/IBM/fp-go/eq"
 	F "github.com/IBM/fp-go/function"
 	L "github.com/IBM/fp-go/optics/lens"
 	OPT "github.com/IBM/fp-go/optics/optional"
 	OPTP "github.com/IBM/fp-go/optics/optional/prism"
+	OT "github.com/IBM/fp-go/optics/optional/testing"
 	O "github.com/IBM/fp-go/option"
 	"github.com/stretchr/testify/assert"
 )
@@ -76,3 +78,26 @@ func TestCompose(t *testing.T) {
 	assert.Equal(t, O.None[*Inner](), sb.Set(2)(O.None[*Inner]()))
 
 }
+
+func TestComposeLaws(t *testing.T) {
+
+	type Point struct {
+		X int
+	}
+
+	lensx := L.MakeLens(func(p Point) int { return p.X }, func(p Point, x int) Point {
+		p.X = x
+		return p
+	})
+
+	sb := F.Pipe2(
+		OPT.Id[O.Option[Point]](),
+		OPTP.Some[O.Option[Point], Point],
+		Compose[O.Option[Point]](lensx),
+	)
+
+	laws := OT.AssertLaws[O.Option[Point], int](t, EQ.FromStrictEquals[int](), O.FromStrictEquals[Point]())(sb)
+
+	assert.True(t, laws(O.Of(Point{1}), 2))
+	assert.True(t, laws(O.None[Point](), 2))
+}
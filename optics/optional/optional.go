@@ -165,6 +165,27 @@ func SetOption[S, A any](a A) func(Optional[S, A]) func(S) O.Option[S] {
 	return ModifyOption[S](F.Constant1[A](a))
 }
 
+// GetOrElse returns the focused value or the default value if the optional does not match
+func GetOrElse[S, A any](def A) func(Optional[S, A]) func(S) A {
+	return func(sa Optional[S, A]) func(S) A {
+		return F.Flow2(
+			sa.GetOption,
+			O.GetOrElse(F.Constant(def)),
+		)
+	}
+}
+
+// Exists tests if the optional focuses on a value that matches the predicate. It returns false if the
+// optional does not match
+func Exists[S, A any](pred func(A) bool) func(Optional[S, A]) func(S) bool {
+	return func(sa Optional[S, A]) func(S) bool {
+		return F.Flow2(
+			sa.GetOption,
+			O.Fold(F.ConstFalse, pred),
+		)
+	}
+}
+
 func ichain[S, A, B any](sa Optional[S, A], ab func(A) O.Option[B], ba func(B) O.Option[A]) Optional[S, B] {
 	return MakeOptional(
 		F.Flow2(sa.GetOption, O.Chain(ab)),
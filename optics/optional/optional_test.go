@@ -62,3 +62,23 @@ func TestOptional(t *testing.T) {
 	assert.Equal(t, O.Of(sampleResponse.info), responseOptional.GetOption(&sampleResponse))
 	assert.Equal(t, O.None[*Info](), responseOptional.GetOption(&sampleEmptyResponse))
 }
+
+func TestGetOrElse(t *testing.T) {
+	defaultInfo := &Info{}
+
+	getInfo := GetOrElse[*Response](defaultInfo)(responseOptional)
+
+	assert.Equal(t, sampleResponse.info, getInfo(&sampleResponse))
+	assert.Equal(t, defaultInfo, getInfo(&sampleEmptyResponse))
+}
+
+func TestExists(t *testing.T) {
+	hasEmployment := Exists[*Response](func(info *Info) bool {
+		return info.employment != nil
+	})(responseOptional)
+
+	assert.False(t, hasEmployment(&sampleResponse))
+	assert.True(t, hasEmployment(&Response{info: &Info{employment: &Employment{}}}))
+	// the optional yields none
+	assert.False(t, hasEmployment(&sampleEmptyResponse))
+}
@@ -40,3 +40,12 @@ func PrismSome[A any]() P.Prism[O.Option[A], A] {
 func Some[S, A any](soa OPT.Optional[S, O.Option[A]]) OPT.Optional[S, A] {
 	return OPT.Compose[S](AsOptional(PrismSome[A]()))(soa)
 }
+
+// Compose composes a prism with an optional
+func Compose[S, A, B any](ab P.Prism[A, B]) func(sa OPT.Optional[S, A]) OPT.Optional[S, B] {
+	return F.Pipe2(
+		ab,
+		AsOptional[A, B],
+		OPT.Compose[S, A, B],
+	)
+}
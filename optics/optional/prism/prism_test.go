@@ -0,0 +1,66 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prism
+
+import (
+	"testing"
+
+	E "github.com/IBM/fp-go/either"
+	EQ "github.com/IBM/fp-go/eq"
+	F "github.com/IBM/fp-go/function"
+	OPT "github.com/IBM/fp-go/optics/optional"
+	OT "github.com/IBM/fp-go/optics/optional/testing"
+	P "github.com/IBM/fp-go/optics/prism"
+	O "github.com/IBM/fp-go/option"
+	"github.com/stretchr/testify/assert"
+)
+
+type State = O.Option[E.Either[string, int]]
+
+func TestCompose(t *testing.T) {
+	right := P.MakePrism(E.ToOption[string, int], E.Of[string, int])
+
+	sb := F.Pipe2(
+		OPT.Id[State](),
+		Some[State, E.Either[string, int]],
+		Compose[State](right),
+	)
+
+	// check get access
+	assert.Equal(t, O.Of(1), sb.GetOption(O.Of(E.Of[string](1))))
+	assert.Equal(t, O.None[int](), sb.GetOption(O.Of(E.Left[int]("a"))))
+	assert.Equal(t, O.None[int](), sb.GetOption(O.None[E.Either[string, int]]()))
+
+	// check set access
+	assert.Equal(t, O.Of(E.Of[string](2)), sb.Set(2)(O.Of(E.Of[string](1))))
+	assert.Equal(t, O.Of(E.Left[int]("a")), sb.Set(2)(O.Of(E.Left[int]("a"))))
+}
+
+func TestComposeLaws(t *testing.T) {
+	right := P.MakePrism(E.ToOption[string, int], E.Of[string, int])
+
+	sb := F.Pipe2(
+		OPT.Id[State](),
+		Some[State, E.Either[string, int]],
+		Compose[State](right),
+	)
+
+	laws := OT.AssertLaws[State, int](t, EQ.FromStrictEquals[int](), O.Eq(E.FromStrictEquals[string, int]()))(sb)
+
+	assert.True(t, laws(O.Of(E.Of[string](1)), 2))
+	assert.True(t, laws(O.Of(E.Left[int]("a")), 2))
+	assert.True(t, laws(O.None[E.Either[string, int]](), 2))
+}
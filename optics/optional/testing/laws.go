@@ -0,0 +1,101 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package testing
+
+import (
+	"testing"
+
+	E "github.com/IBM/fp-go/eq"
+	F "github.com/IBM/fp-go/function"
+	OPT "github.com/IBM/fp-go/optics/optional"
+	O "github.com/IBM/fp-go/option"
+	"github.com/stretchr/testify/assert"
+)
+
+// OptionalGetOptionSet tests the law:
+// getOption(s).fold(() => s, a => set(a)(s)) = s
+func OptionalGetOptionSet[S, A any](
+	t *testing.T,
+	eqs E.Eq[S],
+) func(o OPT.Optional[S, A]) func(s S, a A) bool {
+
+	return func(o OPT.Optional[S, A]) func(s S, a A) bool {
+
+		return func(s S, a A) bool {
+			return assert.True(t, eqs.Equals(O.MonadFold(o.GetOption(s), F.Constant(s), func(a A) S {
+				return o.Set(a)(s)
+			}), s), "Optional getOption(s).fold(() => s, a => set(a)(s)) = s")
+		}
+	}
+}
+
+// OptionalSetGetOption tests the law:
+// getOption(set(a)(s)) = getOption(s).map(_ => a)
+func OptionalSetGetOption[S, A any](
+	t *testing.T,
+	eqa E.Eq[A],
+) func(o OPT.Optional[S, A]) func(s S, a A) bool {
+
+	eqoa := O.Eq(eqa)
+
+	return func(o OPT.Optional[S, A]) func(s S, a A) bool {
+
+		return func(s S, a A) bool {
+			return assert.True(t, eqoa.Equals(o.GetOption(o.Set(a)(s)), O.MonadMap(o.GetOption(s), F.Constant1[A](a))), "Optional getOption(set(a)(s)) = getOption(s).map(_ => a)")
+		}
+	}
+}
+
+// OptionalSetSet tests the law:
+// set(a)(set(a)(s)) = set(a)(s)
+func OptionalSetSet[S, A any](
+	t *testing.T,
+	eqs E.Eq[S],
+) func(o OPT.Optional[S, A]) func(s S, a A) bool {
+
+	return func(o OPT.Optional[S, A]) func(s S, a A) bool {
+
+		return func(s S, a A) bool {
+			return assert.True(t, eqs.Equals(o.Set(a)(o.Set(a)(s)), o.Set(a)(s)), "Optional set(a)(set(a)(s)) = set(a)(s)")
+		}
+	}
+}
+
+// AssertLaws tests the optional laws
+//
+// getOption(s).fold(() => s, a => set(a)(s)) = s
+// getOption(set(a)(s)) = getOption(s).map(_ => a)
+// set(a)(set(a)(s)) = set(a)(s)
+func AssertLaws[S, A any](
+	t *testing.T,
+	eqa E.Eq[A],
+	eqs E.Eq[S],
+) func(o OPT.Optional[S, A]) func(s S, a A) bool {
+
+	getOptionSet := OptionalGetOptionSet[S, A](t, eqs)
+	setGetOption := OptionalSetGetOption[S](t, eqa)
+	setSet := OptionalSetSet[S, A](t, eqs)
+
+	return func(o OPT.Optional[S, A]) func(s S, a A) bool {
+
+		gos := getOptionSet(o)
+		sgo := setGetOption(o)
+		ss := setSet(o)
+
+		return func(s S, a A) bool {
+			return gos(s, a) && sgo(s, a) && ss(s, a)
+		}
+	}
+}
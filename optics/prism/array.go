@@ -0,0 +1,57 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prism
+
+import (
+	AR "github.com/IBM/fp-go/array"
+	F "github.com/IBM/fp-go/function"
+	O "github.com/IBM/fp-go/option"
+	PR "github.com/IBM/fp-go/predicate"
+)
+
+// exists tests if the structure matches the prism and if the focused value satisfies the predicate
+func exists[S, A any](pred func(A) bool, sa Prism[S, A]) func(S) bool {
+	return F.Flow2(
+		sa.GetOption,
+		O.Fold(F.ConstFalse, pred),
+	)
+}
+
+// Count returns the number of elements of an array that match the prism
+func Count[S, A any](sa Prism[S, A]) func([]S) int {
+	return F.Flow2(
+		AR.FilterMap(sa.GetOption),
+		AR.Size[A],
+	)
+}
+
+// Any tests if at least one element of an array matches the prism and satisfies the predicate
+func Any[S, A any](pred func(A) bool) func(Prism[S, A]) func([]S) bool {
+	return func(sa Prism[S, A]) func([]S) bool {
+		return AR.Any(exists(pred, sa))
+	}
+}
+
+// All tests if all elements of an array match the prism and satisfy the predicate. It returns true for an
+// empty array
+func All[S, A any](pred func(A) bool) func(Prism[S, A]) func([]S) bool {
+	return func(sa Prism[S, A]) func([]S) bool {
+		anyFails := AR.Any(PR.Not(exists(pred, sa)))
+		return func(as []S) bool {
+			return !anyFails(as)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prism
+
+import (
+	"testing"
+
+	AR "github.com/IBM/fp-go/array"
+	E "github.com/IBM/fp-go/either"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountAnyAll(t *testing.T) {
+	right := MakePrism(E.ToOption[string, int], E.Of[string, int])
+
+	mixed := AR.From(
+		E.Of[string](1),
+		E.Left[int]("a"),
+		E.Of[string](2),
+		E.Of[string](3),
+	)
+	rights := AR.From(
+		E.Of[string](2),
+		E.Of[string](4),
+	)
+
+	isEven := func(n int) bool {
+		return n%2 == 0
+	}
+
+	assert.Equal(t, 3, Count(right)(mixed))
+	assert.Equal(t, 0, Count(right)(AR.Empty[E.Either[string, int]]()))
+
+	assert.True(t, Any[E.Either[string, int]](isEven)(right)(mixed))
+	assert.False(t, Any[E.Either[string, int]](isEven)(right)(AR.From(E.Of[string](1), E.Left[int]("a"))))
+
+	assert.False(t, All[E.Either[string, int]](isEven)(right)(mixed))
+	assert.True(t, All[E.Either[string, int]](isEven)(right)(rights))
+	// a non matching element fails the check
+	assert.False(t, All[E.Either[string, int]](isEven)(right)(AR.From(E.Of[string](2), E.Left[int]("a"))))
+	assert.True(t, All[E.Either[string, int]](isEven)(right)(AR.Empty[E.Either[string, int]]()))
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package prism
+
+import (
+	F "github.com/IBM/fp-go/function"
+	L "github.com/IBM/fp-go/optics/lens"
+	OPT "github.com/IBM/fp-go/optics/optional"
+	O "github.com/IBM/fp-go/option"
+)
+
+// ComposeLens composes a `Prism` with a `Lens`. The result is an `Optional` because the `Prism` might not match,
+// in which case setting a value leaves the structure unchanged.
+func ComposeLens[S, A, B any](ab L.Lens[A, B]) func(Prism[S, A]) OPT.Optional[S, B] {
+	return func(sa Prism[S, A]) OPT.Optional[S, B] {
+		return OPT.MakeOptional(
+			F.Flow2(
+				sa.GetOption,
+				O.Map(ab.Get),
+			),
+			func(s S, b B) S {
+				return prismModify(ab.Set(b), sa, s)
+			},
+		)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package prism
+
+import (
+	"testing"
+
+	EQ "github.com/IBM/fp-go/eq"
+	F "github.com/IBM/fp-go/function"
+	L "github.com/IBM/fp-go/optics/lens"
+	OT "github.com/IBM/fp-go/optics/optional/testing"
+	O "github.com/IBM/fp-go/option"
+	"github.com/stretchr/testify/assert"
+)
+
+type point struct {
+	x, y int
+}
+
+func TestComposeLens(t *testing.T) {
+	x := L.MakeLens(func(p point) int { return p.x }, func(p point, x int) point {
+		p.x = x
+		return p
+	})
+
+	sx := F.Pipe1(
+		MakePrism(F.Identity[O.Option[point]], O.Some[point]),
+		ComposeLens[O.Option[point]](x),
+	)
+
+	// check get access
+	assert.Equal(t, O.None[int](), sx.GetOption(O.None[point]()))
+	assert.Equal(t, O.Of(1), sx.GetOption(O.Of(point{1, 2})))
+
+	// check set access
+	assert.Equal(t, O.Of(point{3, 2}), sx.Set(3)(O.Of(point{1, 2})))
+	assert.Equal(t, O.None[point](), sx.Set(3)(O.None[point]()))
+
+	// check laws
+	laws := OT.AssertLaws(t, EQ.FromStrictEquals[int](), O.FromStrictEquals[point]())(sx)
+
+	assert.True(t, laws(O.Of(point{1, 2}), 3))
+	assert.True(t, laws(O.None[point](), 3))
+}
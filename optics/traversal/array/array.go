@@ -14,3 +14,22 @@
 // limitations under the License.
 
 package array
+
+import (
+	AR "github.com/IBM/fp-go/array"
+	M "github.com/IBM/fp-go/monoid"
+	G "github.com/IBM/fp-go/optics/traversal/array/generic/const"
+	T "github.com/IBM/fp-go/tuple"
+)
+
+// FoldMapWithIndex maps each element of an array together with its original index to a [M.Monoid] and combines the results
+func FoldMapWithIndex[E, A any](m M.Monoid[E]) func(func(int, A) E) func([]A) E {
+	return G.FoldMapWithIndex[[]A](m)
+}
+
+// GetAllIndexed gets all elements of an array together with their original index
+func GetAllIndexed[A any](as []A) []T.Tuple2[int, A] {
+	return FoldMapWithIndex[[]T.Tuple2[int, A], A](AR.Monoid[T.Tuple2[int, A]]())(func(idx int, a A) []T.Tuple2[int, A] {
+		return AR.Of(T.MakeTuple2(idx, a))
+	})(as)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"testing"
+
+	AR "github.com/IBM/fp-go/array"
+	S "github.com/IBM/fp-go/string"
+	T "github.com/IBM/fp-go/tuple"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllIndexed(t *testing.T) {
+	as := AR.From("a", "b", "c")
+
+	assert.Equal(t, AR.From(
+		T.MakeTuple2(0, "a"),
+		T.MakeTuple2(1, "b"),
+		T.MakeTuple2(2, "c"),
+	), GetAllIndexed(as))
+	assert.Empty(t, GetAllIndexed(AR.Empty[string]()))
+}
+
+func TestFoldMapWithIndex(t *testing.T) {
+	as := AR.From("a", "b", "c")
+
+	folded := FoldMapWithIndex[string, string](S.Monoid)(func(idx int, s string) string {
+		return fmt.Sprintf("%s%d", s, idx)
+	})(as)
+
+	assert.Equal(t, "a0b1c2", folded)
+}
@@ -26,3 +26,9 @@ import (
 func FromArray[E, A any](m M.Monoid[E]) G.Traversal[[]A, A, C.Const[E, []A], C.Const[E, A]] {
 	return AR.FromArray[[]A, E, A](m)
 }
+
+// Filtered returns a traversal from an array for the [C.Const] functor that only focuses on the elements matching the predicate,
+// the focused elements are combined using the given [M.Monoid]
+func Filtered[E, A any](m M.Monoid[E], pred func(A) bool) G.Traversal[[]A, A, C.Const[E, []A], C.Const[E, A]] {
+	return AR.Filtered[[]A, E, A](m, pred)
+}
@@ -17,6 +17,7 @@ package generic
 
 import (
 	C "github.com/IBM/fp-go/constant"
+	F "github.com/IBM/fp-go/function"
 	M "github.com/IBM/fp-go/monoid"
 	AR "github.com/IBM/fp-go/optics/traversal/array/generic"
 	G "github.com/IBM/fp-go/optics/traversal/generic"
@@ -30,3 +31,30 @@ func FromArray[GA ~[]A, E, A any](m M.Monoid[E]) G.Traversal[GA, A, C.Const[E, G
 		C.Ap[E, A, GA](m),
 	)
 }
+
+// Filtered returns a traversal from an array for the const monad that only focuses on the elements matching the predicate
+func Filtered[GA ~[]A, E, A any](m M.Monoid[E], pred func(A) bool) G.Traversal[GA, A, C.Const[E, GA], C.Const[E, A]] {
+	return AR.Filtered[GA, A, C.Const[E, A], C.Const[E, func(A) GA], C.Const[E, GA]](
+		C.Of[E, A](m),
+		C.Of[E, GA](m),
+		C.Map[E, GA, func(A) GA],
+		C.Ap[E, A, GA](m),
+	)(pred)
+}
+
+// FoldMapWithIndex maps each element of an array together with its index to a [M.Monoid] and combines the results
+func FoldMapWithIndex[GA ~[]A, E, A any](m M.Monoid[E]) func(func(int, A) E) func(GA) E {
+	fa := AR.FromArrayWithIndex[GA, GA, A, A, C.Const[E, A], C.Const[E, func(A) GA], C.Const[E, GA]](
+		C.Of[E, GA](m),
+		C.Map[E, GA, func(A) GA],
+		C.Ap[E, A, GA](m),
+	)
+	return func(f func(int, A) E) func(GA) E {
+		return F.Flow2(
+			fa(func(idx int, a A) C.Const[E, A] {
+				return C.Make[E, A](f(idx, a))
+			}),
+			C.Unwrap[E, GA],
+		)
+	}
+}
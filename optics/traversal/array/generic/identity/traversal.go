@@ -29,3 +29,13 @@ func FromArray[GA ~[]A, A any]() G.Traversal[GA, A, GA, A] {
 		I.Ap[GA, A],
 	)
 }
+
+// Filtered returns a traversal from an array for the identity monad that only focuses on the elements matching the predicate
+func Filtered[GA ~[]A, A any](pred func(A) bool) G.Traversal[GA, A, GA, A] {
+	return AR.Filtered[GA, A, A, func(A) GA, GA](
+		I.Of[A],
+		I.Of[GA],
+		I.Map[GA, func(A) GA],
+		I.Ap[GA, A],
+	)(pred)
+}
@@ -32,3 +32,37 @@ func FromArray[GA ~[]A, GB ~[]B, A, B, HKTB, HKTAB, HKTRB any](
 		}
 	}
 }
+
+// Filtered returns a traversal from an array that only focuses on the elements matching the predicate. Elements
+// that do not match are kept in place
+func Filtered[GA ~[]A, A, HKTA, HKTAA, HKTRA any](
+	fofa func(A) HKTA,
+	fof func(GA) HKTRA,
+	fmap func(func(GA) func(A) GA) func(HKTRA) HKTAA,
+	fap func(HKTA) func(HKTAA) HKTRA,
+) func(func(A) bool) G.Traversal[GA, A, HKTRA, HKTA] {
+	return func(pred func(A) bool) G.Traversal[GA, A, HKTRA, HKTA] {
+		fa := FromArray[GA, GA, A, A, HKTA, HKTAA, HKTRA](fof, fmap, fap)
+		return func(f func(A) HKTA) func(GA) HKTRA {
+			return fa(func(a A) HKTA {
+				if pred(a) {
+					return f(a)
+				}
+				return fofa(a)
+			})
+		}
+	}
+}
+
+// FromArrayWithIndex returns an indexed traversal from an array, the function receives the original position of each element
+func FromArrayWithIndex[GA ~[]A, GB ~[]B, A, B, HKTB, HKTAB, HKTRB any](
+	fof func(GB) HKTRB,
+	fmap func(func(GB) func(B) GB) func(HKTRB) HKTAB,
+	fap func(HKTB) func(HKTAB) HKTRB,
+) func(func(int, A) HKTB) func(GA) HKTRB {
+	return func(f func(int, A) HKTB) func(s GA) HKTRB {
+		return func(s GA) HKTRB {
+			return AR.MonadTraverseWithIndex(fof, fmap, fap, s, f)
+		}
+	}
+}
@@ -24,3 +24,8 @@ import (
 func FromArray[A any]() G.Traversal[[]A, A, []A, A] {
 	return AR.FromArray[[]A, A]()
 }
+
+// Filtered returns a traversal from an array for the identity monad that only focuses on the elements matching the predicate
+func Filtered[A any](pred func(A) bool) G.Traversal[[]A, A, []A, A] {
+	return AR.Filtered[[]A](pred)
+}
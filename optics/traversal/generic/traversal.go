@@ -19,6 +19,7 @@ import (
 	AR "github.com/IBM/fp-go/array/generic"
 	C "github.com/IBM/fp-go/constant"
 	F "github.com/IBM/fp-go/function"
+	P "github.com/IBM/fp-go/optics/prism"
 )
 
 type (
@@ -71,3 +72,11 @@ func GetAll[GA ~[]A, S, A any](s S) func(sa Traversal[S, A, C.Const[GA, S], C.Co
 		return fmap(sa)(s)
 	}
 }
+
+// FromPrism converts a `Prism` into a `Traversal` that focuses on zero or one target
+func FromPrism[S, A, HKTS, HKTA any](
+	fof func(S) HKTS,
+	fmap func(HKTA, func(A) S) HKTS,
+) func(P.Prism[S, A]) Traversal[S, A, HKTS, HKTA] {
+	return P.AsTraversal[Traversal[S, A, HKTS, HKTA]](fof, fmap)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constant
+
+import (
+	"testing"
+
+	AR "github.com/IBM/fp-go/array"
+	NEA "github.com/IBM/fp-go/array/nonempty"
+	T "github.com/IBM/fp-go/optics/traversal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAll(t *testing.T) {
+	as := NEA.From(1, 2, 3)
+
+	getall := T.GetAll[NEA.NonEmptyArray[int], int](as)(FromNonEmptyArray[[]int, int](AR.Monoid[int]()))
+
+	assert.Equal(t, AR.From(1, 2, 3), getall)
+}
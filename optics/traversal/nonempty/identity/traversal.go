@@ -0,0 +1,28 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identity
+
+import (
+	NEA "github.com/IBM/fp-go/array/nonempty"
+	AR "github.com/IBM/fp-go/optics/traversal/array/generic/identity"
+	G "github.com/IBM/fp-go/optics/traversal/generic"
+)
+
+// FromNonEmptyArray returns a traversal from a [NEA.NonEmptyArray] for the identity monad. Modifying the
+// elements preserves the non-emptiness of the array in its type
+func FromNonEmptyArray[A any]() G.Traversal[NEA.NonEmptyArray[A], A, NEA.NonEmptyArray[A], A] {
+	return AR.FromArray[NEA.NonEmptyArray[A]]()
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identity
+
+import (
+	"strings"
+	"testing"
+
+	NEA "github.com/IBM/fp-go/array/nonempty"
+	F "github.com/IBM/fp-go/function"
+	I "github.com/IBM/fp-go/identity"
+	L "github.com/IBM/fp-go/optics/lens"
+	LG "github.com/IBM/fp-go/optics/lens/generic"
+	T "github.com/IBM/fp-go/optics/traversal"
+	G "github.com/IBM/fp-go/optics/traversal/generic"
+	"github.com/stretchr/testify/assert"
+)
+
+type Article struct {
+	title string
+	tags  NEA.NonEmptyArray[string]
+}
+
+func TestFromNonEmptyArray(t *testing.T) {
+	tags := L.MakeLens(func(a Article) NEA.NonEmptyArray[string] { return a.tags }, func(a Article, tags NEA.NonEmptyArray[string]) Article {
+		a.tags = tags
+		return a
+	})
+
+	allTags := F.Pipe2(
+		tags,
+		LG.AsTraversal[G.Traversal[Article, NEA.NonEmptyArray[string], Article, NEA.NonEmptyArray[string]]](I.MonadMap[NEA.NonEmptyArray[string], Article]),
+		T.Compose[Article, NEA.NonEmptyArray[string], string, Article](FromNonEmptyArray[string]()),
+	)
+
+	article := Article{title: "optics", tags: NEA.From("go", "fp")}
+
+	updated := T.Modify[Article](strings.ToUpper)(allTags)(article)
+	assert.Equal(t, NEA.From("GO", "FP"), updated.tags)
+	assert.Equal(t, NEA.From("x", "x"), T.Set[Article]("x")(allTags)(article).tags)
+	// the original is unchanged
+	assert.Equal(t, NEA.From("go", "fp"), article.tags)
+}
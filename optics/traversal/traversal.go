@@ -18,6 +18,8 @@ package traversal
 import (
 	C "github.com/IBM/fp-go/constant"
 	F "github.com/IBM/fp-go/function"
+	I "github.com/IBM/fp-go/identity"
+	P "github.com/IBM/fp-go/optics/prism"
 	G "github.com/IBM/fp-go/optics/traversal/generic"
 )
 
@@ -64,3 +66,9 @@ func Compose[
 		HKTS, HKTA, HKTB,
 	](ab)
 }
+
+// FromPrism converts a `Prism` into a `Traversal` for the identity monad. Structures that do not match the
+// `Prism` are left unchanged
+func FromPrism[S, A any](sa P.Prism[S, A]) G.Traversal[S, A, S, A] {
+	return G.FromPrism[S, A](I.Of[S], I.MonadMap[A, S])(sa)
+}
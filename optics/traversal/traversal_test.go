@@ -20,9 +20,11 @@ import (
 
 	AR "github.com/IBM/fp-go/array"
 	C "github.com/IBM/fp-go/constant"
+	E "github.com/IBM/fp-go/either"
 	F "github.com/IBM/fp-go/function"
 	"github.com/IBM/fp-go/internal/utils"
 	N "github.com/IBM/fp-go/number"
+	P "github.com/IBM/fp-go/optics/prism"
 	AT "github.com/IBM/fp-go/optics/traversal/array/const"
 	AI "github.com/IBM/fp-go/optics/traversal/array/identity"
 	"github.com/stretchr/testify/assert"
@@ -77,3 +79,38 @@ func TestTraverse(t *testing.T) {
 
 	assert.Equal(t, AR.From(2, 4, 6), res)
 }
+
+func TestFromPrism(t *testing.T) {
+
+	right := P.MakePrism(E.ToOption[string, []int], E.Of[string, []int])
+
+	sa := F.Pipe1(
+		FromPrism(right),
+		Compose[E.Either[string, []int], []int, int, E.Either[string, []int]](AI.FromArray[int]()),
+	)
+
+	assert.Equal(t, E.Of[string](AR.From(2, 4, 6)), Modify[E.Either[string, []int], int](utils.Double)(sa)(E.Of[string](AR.From(1, 2, 3))))
+	assert.Equal(t, E.Left[[]int]("a"), Modify[E.Either[string, []int], int](utils.Double)(sa)(E.Left[[]int]("a")))
+}
+
+func TestFiltered(t *testing.T) {
+
+	as := AR.From(1, 2, 3, 4, 5)
+
+	isEven := func(n int) bool {
+		return n%2 == 0
+	}
+
+	// get all focuses on the matching elements only
+	getall := GetAll[[]int, int](as)(AT.Filtered(AR.Monoid[int](), isEven))
+	assert.Equal(t, AR.From(2, 4), getall)
+
+	// modify writes back into the matching slots only
+	tr := AI.Filtered(isEven)
+	assert.Equal(t, AR.From(1, 20, 3, 40, 5), Modify[[]int](func(n int) int { return n * 10 })(tr)(as))
+	assert.Equal(t, AR.From(1, 0, 3, 0, 5), Set[[]int](0)(tr)(as))
+
+	// round trip
+	assert.Equal(t, as, Modify[[]int](F.Identity[int])(tr)(as))
+	assert.Equal(t, AR.From(1, 2, 3, 4, 5), as)
+}
@@ -48,3 +48,23 @@ func TestSequenceArray(t *testing.T) {
 		t.Run(fmt.Sprintf("TestSequenceArray %d", i), s(i))
 	}
 }
+
+func TestTraverseArrayWithIndex(t *testing.T) {
+	// rejects elements at even positions
+	oddOnly := TraverseArrayWithIndex(func(idx int, s string) Option[string] {
+		if idx%2 == 0 {
+			return None[string]()
+		}
+		return Some(fmt.Sprintf("%s%d", s, idx))
+	})
+
+	assert.Equal(t, None[[]string](), oddOnly([]string{"a", "b", "c"}))
+	assert.Equal(t, Some([]string{}), oddOnly([]string{}))
+
+	// index is the original position
+	withIndex := TraverseArrayWithIndex(func(idx int, s string) Option[string] {
+		return Some(fmt.Sprintf("%s%d", s, idx))
+	})
+
+	assert.Equal(t, Some([]string{"a0", "b1", "c2"}), withIndex([]string{"a", "b", "c"}))
+}
@@ -18,6 +18,7 @@ package option
 import (
 	F "github.com/IBM/fp-go/function"
 	M "github.com/IBM/fp-go/monoid"
+	"github.com/IBM/fp-go/ord"
 	S "github.com/IBM/fp-go/semigroup"
 )
 
@@ -70,3 +71,25 @@ func AltMonoid[A any]() M.Monoid[Option[A]] {
 		MonadAlt[A],
 	)
 }
+
+// MaxMonoid returns a [Monoid] that keeps the larger of two `Some` values based on the provided order. The
+// identity is `None`, so the maximum is defined for every ordered type, not only for bounded ones.
+func MaxMonoid[A any](o ord.Ord[A]) M.Monoid[Option[A]] {
+	return Monoid[A]()(S.MakeSemigroup(ord.Max(o)))
+}
+
+// MinMonoid returns a [Monoid] that keeps the smaller of two `Some` values based on the provided order. The
+// identity is `None`, so the minimum is defined for every ordered type, not only for bounded ones.
+func MinMonoid[A any](o ord.Ord[A]) M.Monoid[Option[A]] {
+	return Monoid[A]()(S.MakeSemigroup(ord.Min(o)))
+}
+
+// FirstMonoid returns a [Monoid] that keeps the first `Some` value. The identity is `None`
+func FirstMonoid[A any]() M.Monoid[Option[A]] {
+	return Monoid[A]()(S.First[A]())
+}
+
+// LastMonoid returns a [Monoid] that keeps the last `Some` value. The identity is `None`
+func LastMonoid[A any]() M.Monoid[Option[A]] {
+	return Monoid[A]()(S.Last[A]())
+}
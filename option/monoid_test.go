@@ -0,0 +1,53 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package option
+
+import (
+	"testing"
+
+	M "github.com/IBM/fp-go/monoid"
+	"github.com/IBM/fp-go/ord"
+	S "github.com/IBM/fp-go/string"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxMonoid(t *testing.T) {
+	m := MaxMonoid(S.Ord)
+
+	assert.Equal(t, None[string](), M.ConcatAll(m)(nil))
+	assert.Equal(t, Some("c"), M.ConcatAll(m)([]Option[string]{Some("b"), None[string](), Some("c"), Some("a")}))
+}
+
+func TestMinMonoid(t *testing.T) {
+	m := MinMonoid(ord.FromStrictCompare[int]())
+
+	assert.Equal(t, None[int](), M.ConcatAll(m)(nil))
+	assert.Equal(t, Some(1), M.ConcatAll(m)([]Option[int]{Some(2), None[int](), Some(1), Some(3)}))
+}
+
+func TestFirstMonoid(t *testing.T) {
+	m := FirstMonoid[int]()
+
+	assert.Equal(t, None[int](), M.ConcatAll(m)(nil))
+	assert.Equal(t, Some(1), M.ConcatAll(m)([]Option[int]{None[int](), Some(1), Some(2)}))
+}
+
+func TestLastMonoid(t *testing.T) {
+	m := LastMonoid[int]()
+
+	assert.Equal(t, None[int](), M.ConcatAll(m)(nil))
+	assert.Equal(t, Some(2), M.ConcatAll(m)([]Option[int]{None[int](), Some(1), Some(2)}))
+	assert.Equal(t, Some(2), M.ConcatAll(m)([]Option[int]{Some(2), None[int]()}))
+}
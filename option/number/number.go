@@ -18,7 +18,9 @@ package number
 import (
 	"strconv"
 
+	C "github.com/IBM/fp-go/constraints"
 	F "github.com/IBM/fp-go/function"
+	N "github.com/IBM/fp-go/number"
 	O "github.com/IBM/fp-go/option"
 )
 
@@ -33,3 +35,11 @@ var (
 	// Itoa converts an integer to a string
 	Itoa = F.Flow2(strconv.Itoa, O.Of[string])
 )
+
+// Average computes the arithmetic mean of the elements of an array or returns [O.None] for an empty array
+func Average[A C.Integer | C.Float](as []A) O.Option[float64] {
+	if len(as) == 0 {
+		return O.None[float64]()
+	}
+	return O.Some(float64(N.Sum(as)) / float64(len(as)))
+}
@@ -19,6 +19,7 @@ import (
 	C "github.com/IBM/fp-go/constraints"
 	F "github.com/IBM/fp-go/function"
 	"github.com/IBM/fp-go/ord"
+	P "github.com/IBM/fp-go/predicate"
 )
 
 // Constructs an order for [Option]
@@ -36,3 +37,13 @@ func Ord[A any](a ord.Ord[A]) ord.Ord[Option[A]] {
 func FromStrictCompare[A C.Ordered]() ord.Ord[Option[A]] {
 	return Ord(ord.FromStrictCompare[A]())
 }
+
+// ClampOption returns `Some` of the value if it lies within the inclusive range `[low, high]` and `None` otherwise.
+// In contrast to [ord.Clamp] values outside of the range are rejected rather than clamped.
+func ClampOption[A any](o ord.Ord[A]) func(A, A) func(A) Option[A] {
+	geq := ord.Geq(o)
+	leq := ord.Leq(o)
+	return func(low, high A) func(A) Option[A] {
+		return FromPredicate(P.And(leq(high))(geq(low)))
+	}
+}
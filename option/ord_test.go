@@ -18,6 +18,7 @@ package option
 import (
 	"testing"
 
+	"github.com/IBM/fp-go/ord"
 	S "github.com/IBM/fp-go/string"
 	"github.com/stretchr/testify/assert"
 )
@@ -44,3 +45,14 @@ func TestOrd(t *testing.T) {
 	assert.Equal(t, 1, os.Compare(Some("b"), Some("a")))
 
 }
+
+func TestClampOption(t *testing.T) {
+
+	clamp := ClampOption(ord.FromStrictCompare[int]())(1, 3)
+
+	assert.Equal(t, None[int](), clamp(0))
+	assert.Equal(t, Some(1), clamp(1))
+	assert.Equal(t, Some(2), clamp(2))
+	assert.Equal(t, Some(3), clamp(3))
+	assert.Equal(t, None[int](), clamp(4))
+}
@@ -28,3 +28,12 @@ func SequencePair[T1, T2 any](t P.Pair[Option[T1], Option[T2]]) Option[P.Pair[T1
 		t,
 	)
 }
+
+// TraversePairTail applies a function returning an [Option] to the tail of a [Pair] and keeps the head. The result is
+// `None` if the function returns `None`. Refer to [PG.TraverseTail] for the implementation for other higher kinded types.
+func TraversePairTail[A, B, B1 any](f func(B) Option[B1]) func(P.Pair[A, B]) Option[P.Pair[A, B1]] {
+	return PG.TraverseTail[func(func(B1) P.Pair[A, B1]) func(Option[B1]) Option[P.Pair[A, B1]]](
+		Map[B1, P.Pair[A, B1]],
+		f,
+	)
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package option
+
+import (
+	"testing"
+
+	P "github.com/IBM/fp-go/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraversePairTail(t *testing.T) {
+
+	positive := TraversePairTail[string](FromPredicate(func(n int) bool {
+		return n > 0
+	}))
+
+	assert.Equal(t, Some(P.MakePair("a", 1)), positive(P.MakePair("a", 1)))
+	assert.Equal(t, None[P.Pair[string, int]](), positive(P.MakePair("a", -1)))
+}
@@ -42,3 +42,25 @@ func TestSequenceT(t *testing.T) {
 	// three with one none
 	assert.Equal(t, None[T.Tuple3[int, string, bool]](), s3(Of(1), Of("a"), None[bool]()))
 }
+
+func TestSequenceTNone(t *testing.T) {
+	// five arguments
+	s5 := SequenceT5[int, string, bool, int, string]
+	assert.Equal(t, Of(T.MakeTuple5(1, "a", true, 2, "b")), s5(Of(1), Of("a"), Of(true), Of(2), Of("b")))
+
+	// a single none makes the result none
+	assert.Equal(t, None[T.Tuple2[int, string]](), SequenceT2(None[int](), Of("a")))
+	assert.Equal(t, None[T.Tuple4[int, string, bool, int]](), SequenceT4(Of(1), Of("a"), Of(true), None[int]()))
+	assert.Equal(t, None[T.Tuple5[int, string, bool, int, string]](), s5(Of(1), None[string](), Of(true), Of(2), Of("b")))
+}
+
+func TestSequenceTuple(t *testing.T) {
+	assert.Equal(t, Of(T.MakeTuple2(1, "a")), SequenceTuple2(T.MakeTuple2(Of(1), Of("a"))))
+	assert.Equal(t, Of(T.MakeTuple3(1, "a", true)), SequenceTuple3(T.MakeTuple3(Of(1), Of("a"), Of(true))))
+	assert.Equal(t, Of(T.MakeTuple4(1, "a", true, 2)), SequenceTuple4(T.MakeTuple4(Of(1), Of("a"), Of(true), Of(2))))
+
+	// a single none makes the result none
+	assert.Equal(t, None[T.Tuple2[int, string]](), SequenceTuple2(T.MakeTuple2(Of(1), None[string]())))
+	assert.Equal(t, None[T.Tuple3[int, string, bool]](), SequenceTuple3(T.MakeTuple3(None[int](), Of("a"), Of(true))))
+	assert.Equal(t, None[T.Tuple4[int, string, bool, int]](), SequenceTuple4(T.MakeTuple4(Of(1), Of("a"), None[bool](), Of(2))))
+}
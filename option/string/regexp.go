@@ -0,0 +1,36 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string
+
+import (
+	"regexp"
+
+	F "github.com/IBM/fp-go/function"
+	O "github.com/IBM/fp-go/option"
+)
+
+// MatchOption returns a function that matches a string against the regular expression. The result contains the
+// full match followed by the submatches of the capturing groups or [O.None] if the string does not match
+func MatchOption(re *regexp.Regexp) func(string) O.Option[[]string] {
+	return F.Flow2(
+		re.FindStringSubmatch,
+		O.FromPredicate(isMatch),
+	)
+}
+
+func isMatch(m []string) bool {
+	return m != nil
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string
+
+import (
+	"regexp"
+	"testing"
+
+	A "github.com/IBM/fp-go/array"
+	O "github.com/IBM/fp-go/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchOption(t *testing.T) {
+	match := MatchOption(regexp.MustCompile(`(\w+)@(\w+)\.com`))
+
+	// multiple groups
+	assert.Equal(t, O.Some(A.From("carsten@ibm.com", "carsten", "ibm")), match("mail carsten@ibm.com"))
+	// no match
+	assert.Equal(t, O.None[[]string](), match("no mail"))
+}
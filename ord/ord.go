@@ -131,6 +131,25 @@ func FromStrictCompare[A C.Ordered]() Ord[A] {
 	return MakeOrd(strictCompare[A], strictEq[A])
 }
 
+// FromMap creates an ordering that compares values by their priority in the map. Values that are not contained in the map
+// are considered lower than all values in the map and equal to each other.
+func FromMap[K comparable](priorities map[K]int) Ord[K] {
+	return FromCompare(func(x, y K) int {
+		px, okx := priorities[x]
+		py, oky := priorities[y]
+		switch {
+		case okx && oky:
+			return strictCompare(px, py)
+		case okx:
+			return +1
+		case oky:
+			return -1
+		default:
+			return 0
+		}
+	})
+}
+
 // Lt tests whether one value is strictly less than another
 func Lt[A any](o Ord[A]) func(A) func(A) bool {
 	return func(second A) func(A) bool {
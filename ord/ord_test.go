@@ -0,0 +1,38 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromMap(t *testing.T) {
+	priority := FromMap(map[string]int{
+		"high":   3,
+		"medium": 2,
+		"low":    1,
+	})
+
+	assert.Equal(t, []string{"low", "medium", "high"}, Sort(priority)([]string{"low", "high", "medium"}))
+	assert.Equal(t, []string{"high", "medium", "low"}, Sort(Reverse(priority))([]string{"low", "high", "medium"}))
+	// unknown values are lower than all known ones
+	assert.Equal(t, []string{"unknown", "low", "high"}, Sort(priority)([]string{"high", "unknown", "low"}))
+	assert.Equal(t, 0, priority.Compare("unknown", "other"))
+	assert.True(t, priority.Equals("low", "low"))
+	assert.False(t, priority.Equals("low", "high"))
+}
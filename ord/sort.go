@@ -0,0 +1,40 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package ord
+
+import (
+	"sort"
+
+	F "github.com/IBM/fp-go/function"
+)
+
+// SortBy implements a stable sort on a copy of the slice given the provided ordering on an extracted key.
+// The input slice is not modified.
+func SortBy[A, B any](o Ord[B], key func(A) B) func([]A) []A {
+	return func(as []A) []A {
+		// copy, even if there is nothing to sort, so the result never aliases the input
+		cpy := make([]A, len(as))
+		copy(cpy, as)
+		sort.SliceStable(cpy, func(i, j int) bool {
+			return o.Compare(key(cpy[i]), key(cpy[j])) < 0
+		})
+		return cpy
+	}
+}
+
+// Sort implements a stable sort on a copy of the slice given the provided ordering. The input slice is not modified.
+func Sort[A any](o Ord[A]) func([]A) []A {
+	return SortBy(o, F.Identity[A])
+}
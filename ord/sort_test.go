@@ -0,0 +1,55 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package ord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSort(t *testing.T) {
+	as := []int{3, 1, 2}
+
+	assert.Equal(t, []int{1, 2, 3}, Sort(FromStrictCompare[int]())(as))
+	assert.Equal(t, []int{3, 2, 1}, Sort(Reverse(FromStrictCompare[int]()))(as))
+	// input is not modified
+	assert.Equal(t, []int{3, 1, 2}, as)
+	// empty slice
+	assert.Empty(t, Sort(FromStrictCompare[int]())(nil))
+	// a single element is copied, too
+	single := []int{1}
+	sorted := Sort(FromStrictCompare[int]())(single)
+	sorted[0] = 2
+	assert.Equal(t, []int{1}, single)
+}
+
+func TestSortBy(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+
+	ps := []person{{"a", 30}, {"b", 20}, {"c", 30}, {"d", 20}}
+
+	byAge := SortBy(FromStrictCompare[int](), func(p person) int {
+		return p.age
+	})
+
+	// stable with respect to the original order
+	assert.Equal(t, []person{{"b", 20}, {"d", 20}, {"a", 30}, {"c", 30}}, byAge(ps))
+	// input is not modified
+	assert.Equal(t, []person{{"a", 30}, {"b", 20}, {"c", 30}, {"d", 20}}, ps)
+}
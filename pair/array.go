@@ -0,0 +1,47 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pair
+
+import (
+	F "github.com/IBM/fp-go/function"
+	RA "github.com/IBM/fp-go/internal/array"
+	M "github.com/IBM/fp-go/monoid"
+)
+
+// TraverseArray applies a function returning a [Pair] to all elements of an array. The heads are concatenated
+// from left to right via the [M.Monoid] and the tails are collected into an array.
+func TraverseArray[W, A, B any](m M.Monoid[W]) func(func(A) Pair[W, B]) func([]A) Pair[W, []B] {
+	of := F.Bind1st(MakePair[W, []B], m.Empty())
+	ap := func(fb Pair[W, B]) func(Pair[W, func(B) []B]) Pair[W, []B] {
+		return func(fbb Pair[W, func(B) []B]) Pair[W, []B] {
+			return MakePair(m.Concat(Head(fbb), Head(fb)), Tail(fbb)(Tail(fb)))
+		}
+	}
+	return func(f func(A) Pair[W, B]) func([]A) Pair[W, []B] {
+		return RA.Traverse[[]A](
+			of,
+			MapTail[W, []B, func(B) []B],
+			ap,
+			f,
+		)
+	}
+}
+
+// SequenceArray concatenates the heads of an array of [Pair]s from left to right via the [M.Monoid] and collects
+// the tails into an array
+func SequenceArray[W, B any](m M.Monoid[W]) func([]Pair[W, B]) Pair[W, []B] {
+	return TraverseArray[W, Pair[W, B], B](m)(F.Identity[Pair[W, B]])
+}
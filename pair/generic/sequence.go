@@ -69,3 +69,24 @@ func TraversePair[
 		fap1(f2(P.Tail(t))),
 	)
 }
+
+// TraverseTail is a utility function used to implement the traverse operation on the tail of a [Pair] for higher kinded types based only on map.
+// The function transforms the tail into a higher kinded type and returns a higher kinded type of a [Pair] that retains the original head.
+func TraverseTail[
+	MAP ~func(func(B1) P.Pair[A, B1]) func(HKT_B1) HKT_PAIR,
+	FCT ~func(B) HKT_B1,
+	A, B, B1,
+	HKT_B1, // HKT[B1]
+	HKT_PAIR any, // HKT[Pair[A, B1]]
+](
+	fmap MAP,
+	f FCT,
+) func(P.Pair[A, B]) HKT_PAIR {
+	return func(p P.Pair[A, B]) HKT_PAIR {
+		return F.Pipe2(
+			P.Tail(p),
+			f,
+			fmap(F.Bind1st(P.MakePair[A, B1], P.Head(p))),
+		)
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package pair
+
+import (
+	O "github.com/IBM/fp-go/ord"
+)
+
+// Ord constructs a lexicographic ordering for a [Pair] that compares the heads first and then the tails
+func Ord[A, B any](a O.Ord[A], b O.Ord[B]) O.Ord[Pair[A, B]] {
+	return O.MakeOrd(func(l, r Pair[A, B]) int {
+		if c := a.Compare(Head(l), Head(r)); c != 0 {
+			return c
+		}
+		return b.Compare(Tail(l), Tail(r))
+	}, Eq[A, B](a, b).Equals)
+}
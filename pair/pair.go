@@ -208,6 +208,17 @@ func Swap[A, B any](fa Pair[A, B]) Pair[B, A] {
 	return MakePair(Tail(fa), Head(fa))
 }
 
+// Dup is an alias for [Of], it creates a [Pair] with the same value in both fields
+func Dup[A any](a A) Pair[A, A] {
+	return Of(a)
+}
+
+// Fold collapses a [Pair] into a single value by applying a function to both values. It is
+// equivalent to [Paired] but avoids the type parameter of the function.
+func Fold[A, B, R any](f func(A, B) R) func(Pair[A, B]) R {
+	return Paired(f)
+}
+
 // Paired converts a function with 2 parameters into a function taking a [Pair]
 // The inverse function is [Unpaired]
 func Paired[F ~func(T1, T2) R, T1, T2, R any](f F) func(Pair[T1, T2]) R {
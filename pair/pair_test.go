@@ -0,0 +1,62 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package pair
+
+import (
+	"fmt"
+	"testing"
+
+	O "github.com/IBM/fp-go/ord"
+	S "github.com/IBM/fp-go/string"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDup(t *testing.T) {
+	assert.Equal(t, MakePair(1, 1), Dup(1))
+}
+
+func TestFold(t *testing.T) {
+	f := Fold(func(a string, b int) string {
+		return fmt.Sprintf("%s%d", a, b)
+	})
+
+	assert.Equal(t, "a1", f(MakePair("a", 1)))
+}
+
+func TestOrd(t *testing.T) {
+	o := Ord(O.FromStrictCompare[int](), O.FromStrictCompare[string]())
+
+	p1 := MakePair(1, "b")
+	p2 := MakePair(1, "c")
+	p3 := MakePair(2, "a")
+
+	assert.Equal(t, -1, o.Compare(p1, p2))
+	assert.Equal(t, -1, o.Compare(p2, p3))
+	assert.Equal(t, -1, o.Compare(p1, p3))
+	assert.Equal(t, 1, o.Compare(p3, p1))
+	assert.Equal(t, 0, o.Compare(p1, MakePair(1, "b")))
+	assert.True(t, o.Equals(p1, MakePair(1, "b")))
+}
+
+func TestSequenceArray(t *testing.T) {
+	logged := func(n int) Pair[string, int] {
+		return MakePair(fmt.Sprintf("[%d]", n), n*2)
+	}
+
+	seq := SequenceArray[string, int](S.Monoid)
+
+	assert.Equal(t, MakePair("[1][2][3]", []int{2, 4, 6}), seq([]Pair[string, int]{logged(1), logged(2), logged(3)}))
+	assert.Equal(t, MakePair("", []int{}), seq(nil))
+}
@@ -18,12 +18,14 @@ package readerioeither
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 	"testing"
 
 	A "github.com/IBM/fp-go/array"
 	ET "github.com/IBM/fp-go/either"
 	F "github.com/IBM/fp-go/function"
 	TST "github.com/IBM/fp-go/internal/testing"
+	IOE "github.com/IBM/fp-go/ioeither"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -69,3 +71,54 @@ func TestSequenceArrayError(t *testing.T) {
 	// run across four bits
 	s(4)(t)
 }
+
+func TestTraverseArraySeq(t *testing.T) {
+	var order []string
+
+	f := TraverseArraySeq(func(a string) ReaderIOEither[context.Context, string, string] {
+		return func(_ context.Context) IOE.IOEither[string, string] {
+			return func() ET.Either[string, string] {
+				order = append(order, a)
+				if len(a) > 1 {
+					return ET.Left[string](a)
+				}
+				return ET.Right[string](a + a)
+			}
+		}
+	})
+	ctx := context.Background()
+	// effects are executed in order
+	assert.Equal(t, ET.Right[string]([]string{"aa", "bb", "cc"}), F.Pipe1([]string{"a", "b", "c"}, f)(ctx)())
+	assert.Equal(t, []string{"a", "b", "c"}, order)
+	// the first error wins
+	assert.Equal(t, ET.Left[[]string]("bb"), F.Pipe1([]string{"a", "bb", "cc"}, f)(ctx)())
+}
+
+func TestTraverseArrayPar(t *testing.T) {
+	var count atomic.Int64
+
+	f := TraverseArrayPar(func(a string) ReaderIOEither[context.Context, string, string] {
+		return func(_ context.Context) IOE.IOEither[string, string] {
+			return func() ET.Either[string, string] {
+				count.Add(1)
+				if len(a) > 1 {
+					return ET.Left[string](a)
+				}
+				return ET.Right[string](a + a)
+			}
+		}
+	})
+	ctx := context.Background()
+	// results keep the order of the input
+	assert.Equal(t, ET.Right[string]([]string{"aa", "bb", "cc"}), F.Pipe1([]string{"a", "b", "c"}, f)(ctx)())
+	assert.Equal(t, int64(3), count.Load())
+	// the first error wins
+	assert.Equal(t, ET.Left[[]string]("bb"), F.Pipe1([]string{"a", "bb", "cc"}, f)(ctx)())
+}
+
+func TestTraverseArrayWithIndexSeq(t *testing.T) {
+	f := TraverseArrayWithIndexSeq(func(idx int, a string) ReaderIOEither[context.Context, string, string] {
+		return Right[context.Context, string](fmt.Sprintf("%d%s", idx, a))
+	})
+	assert.Equal(t, ET.Right[string]([]string{"0a", "1b"}), F.Pipe1([]string{"a", "b"}, f)(context.Background())())
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	ET "github.com/IBM/fp-go/either"
+	FG "github.com/IBM/fp-go/function/generic"
+)
+
+// ContramapMemoize caches the result of the provided monad per key, the key is derived from the environment. The
+// computation for a key is executed lazily and at most once, both successful and failed results are cached
+func ContramapMemoize[GEA ~func(R) GIOA, GIOA ~func() ET.Either[E, A], R, E, A any, K comparable](kf func(R) K) func(GEA) GEA {
+	memo := FG.ContramapMemoize[func(R) ET.Either[E, A]](kf)
+	return func(rdr GEA) GEA {
+		run := memo(func(r R) ET.Either[E, A] {
+			return rdr(r)()
+		})
+		return func(r R) GIOA {
+			return func() ET.Either[E, A] {
+				return run(r)
+			}
+		}
+	}
+}
+
+// MemoizeByEnv caches the result of the provided monad per environment. The computation for an environment is
+// executed lazily and at most once, both successful and failed results are cached
+func MemoizeByEnv[GEA ~func(R) GIOA, GIOA ~func() ET.Either[E, A], R comparable, E, A any](rdr GEA) GEA {
+	return ContramapMemoize[GEA](func(r R) R { return r })(rdr)
+}
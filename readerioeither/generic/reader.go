@@ -16,6 +16,8 @@
 package generic
 
 import (
+	"time"
+
 	ET "github.com/IBM/fp-go/either"
 	F "github.com/IBM/fp-go/function"
 	C "github.com/IBM/fp-go/internal/chain"
@@ -461,3 +463,13 @@ func Local[
 ](f func(R2) R1) func(GEA1) GEA2 {
 	return RD.Local[GEA1, GEA2](f)
 }
+
+// Delay creates an operation that passes in the value after some [time.Duration]
+func Delay[GEA ~func(R) GIOA, GIOA ~func() ET.Either[E, A], R, E, A any](delay time.Duration) func(GEA) GEA {
+	return RD.Map[GEA, GEA](IOE.Delay[GIOA](delay))
+}
+
+// After creates an operation that passes after the given [time.Time]
+func After[GEA ~func(R) GIOA, GIOA ~func() ET.Either[E, A], R, E, A any](timestamp time.Time) func(GEA) GEA {
+	return RD.Map[GEA, GEA](IOE.After[GIOA](timestamp))
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readerioeither
+
+import (
+	G "github.com/IBM/fp-go/readerioeither/generic"
+)
+
+// MemoizeByEnv caches the result of the provided [ReaderIOEither] per environment. In contrast to [Memoize] the
+// value is computed once for each distinct environment. Both successful and failed results are cached, so a failed
+// computation is not retried for the same environment
+func MemoizeByEnv[R comparable, E, A any](rdr ReaderIOEither[R, E, A]) ReaderIOEither[R, E, A] {
+	return G.MemoizeByEnv[ReaderIOEither[R, E, A]](rdr)
+}
+
+// ContramapMemoize caches the result of the provided [ReaderIOEither] per key, the key is derived from the environment.
+// Use this variant for environments that are not comparable. Both successful and failed results are cached
+func ContramapMemoize[R, E, A any, K comparable](kf func(R) K) func(ReaderIOEither[R, E, A]) ReaderIOEither[R, E, A] {
+	return G.ContramapMemoize[ReaderIOEither[R, E, A]](kf)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readerioeither
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	E "github.com/IBM/fp-go/either"
+	IOE "github.com/IBM/fp-go/ioeither"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoizeByEnv(t *testing.T) {
+	var count atomic.Int32
+
+	square := MemoizeByEnv(func(n int) IOE.IOEither[error, int] {
+		return func() E.Either[error, int] {
+			count.Add(1)
+			if n < 0 {
+				return E.Left[int](fmt.Errorf("negative %d", n))
+			}
+			return E.Of[error](n * n)
+		}
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			n := i % 3
+			assert.Equal(t, E.Of[error](n*n), square(n)())
+		}(i)
+	}
+	wg.Wait()
+
+	// computed once per environment
+	assert.Equal(t, int32(3), count.Load())
+
+	// failures are cached, too
+	assert.Equal(t, E.Left[int](fmt.Errorf("negative -1")), square(-1)())
+	assert.Equal(t, E.Left[int](fmt.Errorf("negative -1")), square(-1)())
+	assert.Equal(t, int32(4), count.Load())
+}
+
+func TestContramapMemoize(t *testing.T) {
+	type Env struct {
+		id    string
+		items []string
+	}
+
+	var count atomic.Int32
+
+	size := ContramapMemoize[Env, error, int](func(e Env) string { return e.id })(func(e Env) IOE.IOEither[error, int] {
+		return func() E.Either[error, int] {
+			count.Add(1)
+			return E.Of[error](len(e.items))
+		}
+	})
+
+	assert.Equal(t, E.Of[error](2), size(Env{"a", []string{"x", "y"}})())
+	assert.Equal(t, E.Of[error](2), size(Env{"a", []string{"x", "y"}})())
+	assert.Equal(t, E.Of[error](1), size(Env{"b", []string{"x"}})())
+	assert.Equal(t, int32(2), count.Load())
+}
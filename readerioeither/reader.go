@@ -16,6 +16,8 @@
 package readerioeither
 
 import (
+	"time"
+
 	ET "github.com/IBM/fp-go/either"
 	"github.com/IBM/fp-go/io"
 	IOE "github.com/IBM/fp-go/ioeither"
@@ -295,3 +297,13 @@ func MapLeft[R, A, E1, E2 any](f func(E1) E2) func(ReaderIOEither[R, E1, A]) Rea
 func Local[R1, R2, E, A any](f func(R2) R1) func(ReaderIOEither[R1, E, A]) ReaderIOEither[R2, E, A] {
 	return G.Local[ReaderIOEither[R1, E, A], ReaderIOEither[R2, E, A]](f)
 }
+
+// Delay creates an operation that passes in the value after some [time.Duration]
+func Delay[R, E, A any](delay time.Duration) func(ReaderIOEither[R, E, A]) ReaderIOEither[R, E, A] {
+	return G.Delay[ReaderIOEither[R, E, A]](delay)
+}
+
+// After creates an operation that passes after the given [time.Time]
+func After[R, E, A any](timestamp time.Time) func(ReaderIOEither[R, E, A]) ReaderIOEither[R, E, A] {
+	return G.After[ReaderIOEither[R, E, A]](timestamp)
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	E "github.com/IBM/fp-go/either"
 	F "github.com/IBM/fp-go/function"
@@ -77,3 +78,33 @@ func TestChainReaderK(t *testing.T) {
 
 	assert.Equal(t, E.Right[error]("1"), g(context.Background())())
 }
+
+func TestDelay(t *testing.T) {
+	delta := 100 * time.Millisecond
+
+	g := F.Pipe1(
+		Of[context.Context, error](1),
+		Delay[context.Context, error, int](delta),
+	)
+
+	t0 := time.Now()
+	res := g(context.Background())()
+	t1 := time.Now()
+
+	assert.Equal(t, E.Of[error](1), res)
+	assert.GreaterOrEqual(t, t1.Sub(t0), delta)
+}
+
+func TestAfter(t *testing.T) {
+	timestamp := time.Now().Add(100 * time.Millisecond)
+
+	g := F.Pipe1(
+		Of[context.Context, error](1),
+		After[context.Context, error, int](timestamp),
+	)
+
+	res := g(context.Background())()
+
+	assert.Equal(t, E.Of[error](1), res)
+	assert.False(t, time.Now().Before(timestamp))
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readerioeither
+
+import (
+	ET "github.com/IBM/fp-go/either"
+	G "github.com/IBM/fp-go/readerioeither/generic"
+	RT "github.com/IBM/fp-go/retry"
+)
+
+// Retrying will retry the actions according to the check policy. The environment is passed to every attempt
+//
+// policy - refers to the retry policy
+// action - converts a status into an operation to be executed
+// check  - checks if the result of the action needs to be retried
+func Retrying[R, E, A any](
+	policy RT.RetryPolicy,
+	action func(RT.RetryStatus) ReaderIOEither[R, E, A],
+	check func(ET.Either[E, A]) bool,
+) ReaderIOEither[R, E, A] {
+	return G.Retrying(policy, action, check)
+}
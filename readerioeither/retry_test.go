@@ -0,0 +1,73 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readerioeither
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	E "github.com/IBM/fp-go/either"
+	IOE "github.com/IBM/fp-go/ioeither"
+	RT "github.com/IBM/fp-go/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+type Counter struct {
+	attempts int
+}
+
+func TestRetrying(t *testing.T) {
+	policy := RT.Monoid.Concat(RT.ConstantDelay(time.Millisecond), RT.LimitRetries(5))
+
+	// succeeds on the third attempt, i.e. after two retries
+	action := func(status RT.RetryStatus) ReaderIOEither[*Counter, error, string] {
+		return func(c *Counter) IOE.IOEither[error, string] {
+			return func() E.Either[error, string] {
+				c.attempts++
+				if c.attempts < 3 {
+					return E.Left[string](fmt.Errorf("attempt %d failed", c.attempts))
+				}
+				return E.Of[error](fmt.Sprintf("attempt %d, retry %d", c.attempts, status.IterNumber))
+			}
+		}
+	}
+
+	counter := Counter{}
+	res := Retrying(policy, action, E.IsLeft[error, string])(&counter)()
+
+	assert.Equal(t, E.Of[error]("attempt 3, retry 2"), res)
+	assert.Equal(t, 3, counter.attempts)
+}
+
+func TestRetryingExhausted(t *testing.T) {
+	policy := RT.Monoid.Concat(RT.ConstantDelay(time.Millisecond), RT.LimitRetries(2))
+
+	action := func(_ RT.RetryStatus) ReaderIOEither[*Counter, error, string] {
+		return func(c *Counter) IOE.IOEither[error, string] {
+			return func() E.Either[error, string] {
+				c.attempts++
+				return E.Left[string](fmt.Errorf("attempt %d failed", c.attempts))
+			}
+		}
+	}
+
+	counter := Counter{}
+	res := Retrying(policy, action, E.IsLeft[error, string])(&counter)()
+
+	assert.Equal(t, E.Left[string](fmt.Errorf("attempt 3 failed")), res)
+	assert.Equal(t, 3, counter.attempts)
+}
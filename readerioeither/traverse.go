@@ -49,3 +49,36 @@ func TraverseRecordWithIndex[R any, K comparable, E, A, B any](f func(K, A) Read
 func SequenceRecord[R any, K comparable, E, A any](ma map[K]ReaderIOEither[R, E, A]) ReaderIOEither[R, E, map[K]A] {
 	return G.SequenceRecord[ReaderIOEither[R, E, A], ReaderIOEither[R, E, map[K]A]](ma)
 }
+
+// TraverseArraySeq transforms an array, the elements are evaluated sequentially
+func TraverseArraySeq[R, E, A, B any](f func(A) ReaderIOEither[R, E, B]) func([]A) ReaderIOEither[R, E, []B] {
+	return G.TraverseArraySeq[ReaderIOEither[R, E, B], ReaderIOEither[R, E, []B], IOE.IOEither[E, B], IOE.IOEither[E, []B], []A](f)
+}
+
+// TraverseArrayWithIndexSeq transforms an array, the elements are evaluated sequentially
+func TraverseArrayWithIndexSeq[R, E, A, B any](f func(int, A) ReaderIOEither[R, E, B]) func([]A) ReaderIOEither[R, E, []B] {
+	return G.TraverseArrayWithIndexSeq[ReaderIOEither[R, E, B], ReaderIOEither[R, E, []B], IOE.IOEither[E, B], IOE.IOEither[E, []B], []A](f)
+}
+
+// SequenceArraySeq converts a homogeneous sequence of Readers into a Reader of a sequence, the elements are evaluated sequentially
+func SequenceArraySeq[R, E, A any](ma []ReaderIOEither[R, E, A]) ReaderIOEither[R, E, []A] {
+	return G.SequenceArraySeq[ReaderIOEither[R, E, A], ReaderIOEither[R, E, []A]](ma)
+}
+
+// TraverseArrayPar transforms an array, the elements are evaluated in parallel.
+// All computations share the same environment, so the environment must be safe for concurrent reads
+func TraverseArrayPar[R, E, A, B any](f func(A) ReaderIOEither[R, E, B]) func([]A) ReaderIOEither[R, E, []B] {
+	return G.TraverseArrayPar[ReaderIOEither[R, E, B], ReaderIOEither[R, E, []B], IOE.IOEither[E, B], IOE.IOEither[E, []B], []A](f)
+}
+
+// TraverseArrayWithIndexPar transforms an array, the elements are evaluated in parallel.
+// All computations share the same environment, so the environment must be safe for concurrent reads
+func TraverseArrayWithIndexPar[R, E, A, B any](f func(int, A) ReaderIOEither[R, E, B]) func([]A) ReaderIOEither[R, E, []B] {
+	return G.TraverseArrayWithIndexPar[ReaderIOEither[R, E, B], ReaderIOEither[R, E, []B], IOE.IOEither[E, B], IOE.IOEither[E, []B], []A](f)
+}
+
+// SequenceArrayPar converts a homogeneous sequence of Readers into a Reader of a sequence, the elements are evaluated in parallel.
+// All computations share the same environment, so the environment must be safe for concurrent reads
+func SequenceArrayPar[R, E, A any](ma []ReaderIOEither[R, E, A]) ReaderIOEither[R, E, []A] {
+	return G.SequenceArrayPar[ReaderIOEither[R, E, A], ReaderIOEither[R, E, []A]](ma)
+}
@@ -18,6 +18,8 @@ package record
 import (
 	"testing"
 
+	A "github.com/IBM/fp-go/array"
+	EQ "github.com/IBM/fp-go/eq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -46,3 +48,27 @@ func TestFromStrictEquals(t *testing.T) {
 	assert.False(t, e.Equals(m1, m2))
 	assert.False(t, e.Equals(m1, m4))
 }
+
+func TestNestedEq(t *testing.T) {
+	m1 := map[string][]int{
+		"a": {1, 2},
+		"b": {3},
+	}
+	m2 := map[string][]int{
+		"a": {1, 2},
+		"b": {3},
+	}
+	m3 := map[string][]int{
+		"a": {1, 2},
+		"b": {3, 4},
+	}
+	m4 := map[string][]int{
+		"a": {1, 2},
+	}
+
+	e := Eq[string](A.Eq(EQ.FromStrictEquals[int]()))
+	assert.True(t, e.Equals(m1, m2))
+	assert.False(t, e.Equals(m1, m3))
+	assert.False(t, e.Equals(m1, m4))
+	assert.False(t, e.Equals(m4, m1))
+}
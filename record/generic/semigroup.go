@@ -36,3 +36,8 @@ func UnionFirstSemigroup[N ~map[K]V, K comparable, V any]() S.Semigroup[N] {
 		return unionLast[N, K, V](second, first)
 	})
 }
+
+// UnionWith combines two maps, values of keys contained in both maps are combined via the [S.Semigroup]
+func UnionWith[N ~map[K]V, K comparable, V any](s S.Semigroup[V]) func(N, N) N {
+	return UnionSemigroup[N](s).Concat
+}
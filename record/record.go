@@ -182,6 +182,11 @@ func FilterWithIndex[K comparable, V any](f func(K, V) bool) func(map[K]V) map[K
 	return G.FilterWithIndex[map[K]V](f)
 }
 
+// FilterWithKey creates a new map with only the elements that match the predicate on key and value. It is an alias for [FilterWithIndex]
+func FilterWithKey[K comparable, V any](f func(K, V) bool) func(map[K]V) map[K]V {
+	return FilterWithIndex(f)
+}
+
 // IsNil checks if the map is set to nil
 func IsNil[K comparable, V any](m map[K]V) bool {
 	return G.IsNil(m)
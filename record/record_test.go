@@ -24,6 +24,7 @@ import (
 	A "github.com/IBM/fp-go/array"
 	"github.com/IBM/fp-go/internal/utils"
 	Mg "github.com/IBM/fp-go/magma"
+	N "github.com/IBM/fp-go/number"
 	O "github.com/IBM/fp-go/option"
 	S "github.com/IBM/fp-go/string"
 	T "github.com/IBM/fp-go/tuple"
@@ -198,3 +199,56 @@ func TestHas(t *testing.T) {
 	assert.True(t, Has("a", nonEmpty))
 	assert.False(t, Has("c", nonEmpty))
 }
+
+func TestFilterWithKey(t *testing.T) {
+	data := map[string]int{
+		"a": 1,
+		"b": 2,
+		"c": 3,
+	}
+	f := FilterWithKey(func(k string, v int) bool {
+		return k != "a" && v%2 != 0
+	})
+
+	assert.Equal(t, map[string]int{"c": 3}, f(data))
+	// the original map is unchanged
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, data)
+}
+
+func TestCollect(t *testing.T) {
+	data := map[string]int{
+		"a": 1,
+		"b": 2,
+	}
+	res := Collect(func(k string, v int) string {
+		return fmt.Sprintf("%s%d", k, v)
+	})(data)
+	sort.Strings(res)
+
+	assert.Equal(t, []string{"a1", "b2"}, res)
+}
+
+func TestMapImmutable(t *testing.T) {
+	data := map[string]int{
+		"a": 1,
+		"b": 2,
+	}
+	res := Map[string](utils.Double)(data)
+
+	assert.Equal(t, map[string]int{"a": 2, "b": 4}, res)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, data)
+}
+
+func TestUnionWith(t *testing.T) {
+	counts := UnionWith[string](N.SemigroupSum[int]())
+
+	left := map[string]int{"a": 1, "b": 2}
+	right := map[string]int{"b": 3, "c": 4}
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 5, "c": 4}, counts(left, right))
+	// the inputs are unchanged
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, left)
+	assert.Equal(t, map[string]int{"b": 3, "c": 4}, right)
+	// the right map wins for a plain merge
+	assert.Equal(t, map[string]int{"a": 1, "b": 3, "c": 4}, Merge(right)(left))
+}
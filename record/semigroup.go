@@ -31,3 +31,9 @@ func UnionLastSemigroup[K comparable, V any]() S.Semigroup[map[K]V] {
 func UnionFirstSemigroup[K comparable, V any]() S.Semigroup[map[K]V] {
 	return G.UnionFirstSemigroup[map[K]V]()
 }
+
+// UnionWith combines two maps, values of keys contained in both maps are combined via the [S.Semigroup].
+// Use [Merge] for a union in which the values of the right map take precedence
+func UnionWith[K comparable, V any](s S.Semigroup[V]) func(map[K]V, map[K]V) map[K]V {
+	return G.UnionWith[map[K]V](s)
+}
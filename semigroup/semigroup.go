@@ -50,6 +50,16 @@ func FunctionSemigroup[A, B any](s Semigroup[B]) Semigroup[func(A) B] {
 	})
 }
 
+// Intercalate returns a semigroup that concatenates two values with the separator in between, i.e.
+// `Concat(x, y) = x <> sep <> y`. Combined with [ConcatAll] N elements are joined with N-1 separators.
+func Intercalate[A any](sep A) func(Semigroup[A]) Semigroup[A] {
+	return func(s Semigroup[A]) Semigroup[A] {
+		return MakeSemigroup(func(x, y A) A {
+			return s.Concat(x, s.Concat(sep, y))
+		})
+	}
+}
+
 // First always returns the first argument.
 func First[A any]() Semigroup[A] {
 	return MakeSemigroup(F.First[A, A])
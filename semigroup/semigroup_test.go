@@ -34,3 +34,16 @@ func TestLast(t *testing.T) {
 
 	assert.Equal(t, 2, last.Concat(1, 2))
 }
+
+func TestIntercalate(t *testing.T) {
+
+	sg := MakeSemigroup(func(x, y string) string {
+		return x + y
+	})
+
+	csv := Intercalate(",")(sg)
+
+	assert.Equal(t, "a,b", csv.Concat("a", "b"))
+	assert.Equal(t, "a,b,c", ConcatAll(csv)("a")([]string{"b", "c"}))
+	assert.Equal(t, "a", ConcatAll(csv)("a")(nil))
+}
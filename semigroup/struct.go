@@ -0,0 +1,67 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package semigroup
+
+import (
+	RA "github.com/IBM/fp-go/internal/array"
+)
+
+// StructBuilder collects the semigroups of the fields of a structure `S`, see [NewStructSemigroup]
+type StructBuilder[S any] struct {
+	fields []Semigroup[S]
+}
+
+// Field returns a semigroup for a structure `S` that combines a single field of the structure using the semigroup for the
+// field and leaves all other fields of the first argument unchanged. The field is accessed via a getter and a setter, the
+// setter must return a (shallow) copy of the structure.
+func Field[S, A any](get func(S) A, set func(S, A) S, s Semigroup[A]) Semigroup[S] {
+	return MakeSemigroup(func(x, y S) S {
+		return set(x, s.Concat(get(x), get(y)))
+	})
+}
+
+// Struct combines the semigroups of individual fields, typically created via [Field], into a semigroup for the
+// whole structure. Fields that are not covered keep the value of the first argument.
+func Struct[S any](fields ...Semigroup[S]) Semigroup[S] {
+	return MakeSemigroup(func(x, y S) S {
+		result := x
+		for _, field := range fields {
+			result = field.Concat(result, y)
+		}
+		return result
+	})
+}
+
+// NewStructSemigroup starts to build a semigroup for the structure `S` field by field, e.g.
+//
+//	NewStructSemigroup[config]().
+//		Field(Field(getName, setName, Last[string]())).
+//		Field(Field(getRetries, setRetries, sum)).
+//		Build()
+//
+// Go methods cannot declare type parameters, so the semigroup of each field is created by the package level [Field] function.
+func NewStructSemigroup[S any]() StructBuilder[S] {
+	return StructBuilder[S]{}
+}
+
+// Field returns a new builder that additionally combines the field described by the semigroup, the builder itself is not modified
+func (b StructBuilder[S]) Field(field Semigroup[S]) StructBuilder[S] {
+	return StructBuilder[S]{fields: RA.Push(b.fields, field)}
+}
+
+// Build returns the semigroup for the structure, see [Struct]
+func (b StructBuilder[S]) Build() Semigroup[S] {
+	return Struct(b.fields...)
+}
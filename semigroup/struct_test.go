@@ -0,0 +1,89 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package semigroup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type config struct {
+	name    string
+	retries int
+	tags    []string
+}
+
+func TestStruct(t *testing.T) {
+
+	sum := MakeSemigroup(func(x, y int) int {
+		return x + y
+	})
+
+	appendTags := MakeSemigroup(func(x, y []string) []string {
+		return append(append([]string{}, x...), y...)
+	})
+
+	sg := Struct(
+		Field(func(c config) string { return c.name }, func(c config, name string) config {
+			c.name = name
+			return c
+		}, Last[string]()),
+		Field(func(c config) int { return c.retries }, func(c config, retries int) config {
+			c.retries = retries
+			return c
+		}, sum),
+		Field(func(c config) []string { return c.tags }, func(c config, tags []string) config {
+			c.tags = tags
+			return c
+		}, appendTags),
+	)
+
+	c1 := config{name: "a", retries: 1, tags: []string{"x"}}
+	c2 := config{name: "b", retries: 2, tags: []string{"y"}}
+
+	assert.Equal(t, config{name: "b", retries: 3, tags: []string{"x", "y"}}, sg.Concat(c1, c2))
+	// inputs are not modified
+	assert.Equal(t, config{name: "a", retries: 1, tags: []string{"x"}}, c1)
+	// uncovered fields keep the first value
+	assert.Equal(t, c1, Struct[config]().Concat(c1, c2))
+}
+
+func TestNewStructSemigroup(t *testing.T) {
+
+	sum := MakeSemigroup(func(x, y int) int {
+		return x + y
+	})
+
+	builder := NewStructSemigroup[config]().
+		Field(Field(func(c config) string { return c.name }, func(c config, name string) config {
+			c.name = name
+			return c
+		}, Last[string]()))
+
+	sg := builder.
+		Field(Field(func(c config) int { return c.retries }, func(c config, retries int) config {
+			c.retries = retries
+			return c
+		}, sum)).
+		Build()
+
+	c1 := config{name: "a", retries: 1, tags: []string{"x"}}
+	c2 := config{name: "b", retries: 2, tags: []string{"y"}}
+
+	assert.Equal(t, config{name: "b", retries: 3, tags: []string{"x"}}, sg.Concat(c1, c2))
+	// adding a field does not modify the original builder
+	assert.Equal(t, config{name: "b", retries: 1, tags: []string{"x"}}, builder.Build().Concat(c1, c2))
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	G "github.com/IBM/fp-go/state/generic"
+)
+
+// TraverseArray transforms an array, threading the state through the elements from left to right
+func TraverseArray[S, A, B any](f func(A) State[S, B]) func([]A) State[S, []B] {
+	return G.TraverseArray[State[S, B], State[S, []B], []A](f)
+}
+
+// TraverseArrayWithIndex transforms an array, threading the state through the elements from left to right
+func TraverseArrayWithIndex[S, A, B any](f func(int, A) State[S, B]) func([]A) State[S, []B] {
+	return G.TraverseArrayWithIndex[State[S, B], State[S, []B], []A](f)
+}
+
+// SequenceArray converts a homogeneous sequence of states into a state of sequence
+func SequenceArray[S, A any](ma []State[S, A]) State[S, []A] {
+	return G.SequenceArray[State[S, A], State[S, []A]](ma)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"fmt"
+	"testing"
+
+	F "github.com/IBM/fp-go/function"
+	P "github.com/IBM/fp-go/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+// label assigns the current counter to the value and increments the counter
+func label(s string) State[int, string] {
+	return func(n int) P.Pair[string, int] {
+		return P.MakePair(fmt.Sprintf("%s%d", s, n), n+1)
+	}
+}
+
+func TestTraverseArray(t *testing.T) {
+	res := F.Pipe1(
+		[]string{"a", "b", "c"},
+		TraverseArray(label),
+	)(1)
+
+	assert.Equal(t, P.MakePair([]string{"a1", "b2", "c3"}, 4), res)
+}
+
+func TestTraverseArrayWithIndex(t *testing.T) {
+	res := F.Pipe1(
+		[]string{"a", "b"},
+		TraverseArrayWithIndex(func(i int, s string) State[int, string] {
+			return label(fmt.Sprintf("%s%d-", s, i))
+		}),
+	)(10)
+
+	assert.Equal(t, P.MakePair([]string{"a0-10", "b1-11"}, 12), res)
+}
+
+func TestSequenceArray(t *testing.T) {
+	inc := Modify(func(n int) int { return n + 1 })
+	res := F.Pipe1(
+		SequenceArray([]State[int, any]{inc, inc, inc}),
+		Execute[[]any](0),
+	)
+
+	assert.Equal(t, 3, res)
+}
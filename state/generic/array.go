@@ -0,0 +1,57 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	F "github.com/IBM/fp-go/function"
+	RA "github.com/IBM/fp-go/internal/array"
+	P "github.com/IBM/fp-go/pair"
+)
+
+// MonadTraverseArray transforms an array, threading the state through the elements from left to right
+func MonadTraverseArray[GB ~func(S) P.Pair[B, S], GBS ~func(S) P.Pair[BBS, S], AAS ~[]A, BBS ~[]B, S, A, B any](tas AAS, f func(A) GB) GBS {
+	return RA.MonadTraverse[AAS](
+		Of[GBS, S, BBS],
+		Map[func(S) P.Pair[func(B) BBS, S], GBS, func(BBS) func(B) BBS],
+		Ap[GBS, func(S) P.Pair[func(B) BBS, S], GB],
+		tas, f,
+	)
+}
+
+// TraverseArray transforms an array, threading the state through the elements from left to right
+func TraverseArray[GB ~func(S) P.Pair[B, S], GBS ~func(S) P.Pair[BBS, S], AAS ~[]A, BBS ~[]B, S, A, B any](f func(A) GB) func(AAS) GBS {
+	return RA.Traverse[AAS](
+		Of[GBS, S, BBS],
+		Map[func(S) P.Pair[func(B) BBS, S], GBS, func(BBS) func(B) BBS],
+		Ap[GBS, func(S) P.Pair[func(B) BBS, S], GB],
+		f,
+	)
+}
+
+// TraverseArrayWithIndex transforms an array, threading the state through the elements from left to right
+func TraverseArrayWithIndex[GB ~func(S) P.Pair[B, S], GBS ~func(S) P.Pair[BBS, S], AAS ~[]A, BBS ~[]B, S, A, B any](f func(int, A) GB) func(AAS) GBS {
+	return RA.TraverseWithIndex[AAS](
+		Of[GBS, S, BBS],
+		Map[func(S) P.Pair[func(B) BBS, S], GBS, func(BBS) func(B) BBS],
+		Ap[GBS, func(S) P.Pair[func(B) BBS, S], GB],
+		f,
+	)
+}
+
+// SequenceArray converts a homogeneous sequence of states into a state of sequence
+func SequenceArray[GA ~func(S) P.Pair[A, S], GAS ~func(S) P.Pair[AAS, S], AAS ~[]A, GAAS ~[]GA, S, A any](ma GAAS) GAS {
+	return MonadTraverseArray[GA, GAS](ma, F.Identity[GA])
+}
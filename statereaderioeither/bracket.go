@@ -0,0 +1,33 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package statereaderioeither
+
+import (
+	ET "github.com/IBM/fp-go/either"
+	G "github.com/IBM/fp-go/statereaderioeither/generic"
+)
+
+// Bracket makes sure that a resource is cleaned up in the event of an error. The release action is called regardless of
+// whether the body action returns and error or not. The release action runs with the state produced by the body action or
+// with the state produced by the acquire action if the body action fails. The final state is the state of the release action.
+func Bracket[
+	S, R, E, A, B, ANY any](
+
+	acquire StateReaderIOEither[S, R, E, A],
+	use func(A) StateReaderIOEither[S, R, E, B],
+	release func(A, ET.Either[E, B]) StateReaderIOEither[S, R, E, ANY],
+) StateReaderIOEither[S, R, E, B] {
+	return G.Bracket(acquire, use, release)
+}
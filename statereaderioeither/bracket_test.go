@@ -0,0 +1,70 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package statereaderioeither
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	E "github.com/IBM/fp-go/either"
+	P "github.com/IBM/fp-go/pair"
+	RIOE "github.com/IBM/fp-go/readerioeither"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBracket(t *testing.T) {
+	// the state records the sequence of steps
+	record := func(step string) func([]string) P.Pair[string, []string] {
+		return func(s []string) P.Pair[string, []string] {
+			return P.MakePair(step, append(append([]string{}, s...), step))
+		}
+	}
+
+	acquire := FromState[[]string, context.Context, error](record("acquire"))
+
+	var released E.Either[error, string]
+	release := func(a string, res E.Either[error, string]) StateReaderIOEither[[]string, context.Context, error, string] {
+		released = res
+		return FromState[[]string, context.Context, error](record("release"))
+	}
+
+	success := func(a string) StateReaderIOEither[[]string, context.Context, error, string] {
+		return FromState[[]string, context.Context, error](record("use"))
+	}
+
+	failure := func(a string) StateReaderIOEither[[]string, context.Context, error, string] {
+		return Left[[]string, context.Context, string](fmt.Errorf("failed"))
+	}
+
+	res1 := Bracket(acquire, success, release)(nil)(context.Background())()
+	assert.Equal(t, E.Of[error](P.MakePair("use", []string{"acquire", "use", "release"})), res1)
+	assert.Equal(t, E.Of[error]("use"), released)
+
+	// release runs with the state of acquire if use fails
+	var state []string
+	trackedRelease := func(a string, res E.Either[error, string]) StateReaderIOEither[[]string, context.Context, error, string] {
+		released = res
+		return func(s []string) RIOE.ReaderIOEither[context.Context, error, P.Pair[string, []string]] {
+			state = s
+			return FromState[[]string, context.Context, error](record("release"))(s)
+		}
+	}
+
+	res2 := Bracket(acquire, failure, trackedRelease)(nil)(context.Background())()
+	assert.Equal(t, E.Left[P.Pair[string, []string]](fmt.Errorf("failed")), res2)
+	assert.Equal(t, E.Left[string](fmt.Errorf("failed")), released)
+	assert.Equal(t, []string{"acquire"}, state)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package generic
+
+import (
+	ET "github.com/IBM/fp-go/either"
+	F "github.com/IBM/fp-go/function"
+	P "github.com/IBM/fp-go/pair"
+	I "github.com/IBM/fp-go/readerio/generic"
+	G "github.com/IBM/fp-go/readerioeither/generic"
+)
+
+// Bracket makes sure that a resource is cleaned up in the event of an error. The release action is called regardless of
+// whether the body action returns and error or not. The release action runs with the state produced by the body action or
+// with the state produced by the acquire action if the body action fails. The final state is the state of the release action.
+func Bracket[
+	SRIOEA ~func(S) RIOEA,
+	SRIOEB ~func(S) RIOEB,
+	SRIOEANY ~func(S) RIOEANY,
+
+	RIOEA ~func(R) IOEA,
+	RIOEB ~func(R) IOEB,
+	RIOEANY ~func(R) IOEANY,
+
+	IOEA ~func() ET.Either[E, P.Pair[A, S]],
+	IOEB ~func() ET.Either[E, P.Pair[B, S]],
+	IOEANY ~func() ET.Either[E, P.Pair[ANY, S]],
+
+	S, R, E, A, B, ANY any](
+
+	acquire SRIOEA,
+	use func(A) SRIOEB,
+	release func(A, ET.Either[E, B]) SRIOEANY,
+) SRIOEB {
+	return MonadChain(acquire, func(a A) SRIOEB {
+		return func(s S) RIOEB {
+			return I.MonadChain[RIOEB, RIOEB](use(a)(s), func(ebs ET.Either[E, P.Pair[B, S]]) RIOEB {
+				eb := ET.Map[E](P.Head[B, S])(ebs)
+				return G.MonadChain[RIOEANY, RIOEB](
+					release(a, eb)(ET.MonadFold(ebs, F.Constant1[E](s), P.Tail[B, S])),
+					func(anys P.Pair[ANY, S]) RIOEB {
+						return G.FromEither[RIOEB](ET.Map[E](F.Bind2nd(P.MakePair[B, S], P.Tail(anys)))(eb))
+					},
+				)
+			})
+		}
+	})
+}
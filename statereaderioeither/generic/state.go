@@ -18,9 +18,11 @@ package generic
 import (
 	ET "github.com/IBM/fp-go/either"
 	F "github.com/IBM/fp-go/function"
+	C "github.com/IBM/fp-go/internal/chain"
 	ST "github.com/IBM/fp-go/internal/statet"
 	P "github.com/IBM/fp-go/pair"
 	G "github.com/IBM/fp-go/readerioeither/generic"
+	SG "github.com/IBM/fp-go/state/generic"
 )
 
 func Left[
@@ -115,6 +117,39 @@ func Chain[
 	)
 }
 
+func MonadChainFirst[
+	SRIOEA ~func(S) RIOEA,
+	SRIOEB ~func(S) RIOEB,
+	RIOEA ~func(R) IOEA,
+	RIOEB ~func(R) IOEB,
+	IOEA ~func() ET.Either[E, P.Pair[A, S]],
+	IOEB ~func() ET.Either[E, P.Pair[B, S]],
+	S, R, E, A, B any,
+](fa SRIOEA, f func(A) SRIOEB) SRIOEA {
+	return C.MonadChainFirst(
+		MonadChain[SRIOEA, SRIOEA],
+		MonadMap[SRIOEB, SRIOEA],
+		fa,
+		f,
+	)
+}
+
+func ChainFirst[
+	SRIOEA ~func(S) RIOEA,
+	SRIOEB ~func(S) RIOEB,
+	RIOEA ~func(R) IOEA,
+	RIOEB ~func(R) IOEB,
+	IOEA ~func() ET.Either[E, P.Pair[A, S]],
+	IOEB ~func() ET.Either[E, P.Pair[B, S]],
+	S, R, E, A, B any,
+](f func(A) SRIOEB) func(SRIOEA) SRIOEA {
+	return C.ChainFirst(
+		Chain[SRIOEA, SRIOEA],
+		Map[SRIOEB, SRIOEA],
+		f,
+	)
+}
+
 func MonadAp[
 	SRIOEA ~func(S) RIOEA,
 	SRIOEB ~func(S) RIOEB,
@@ -257,6 +292,42 @@ func FromState[
 	return ST.FromState[SRIOEA](G.Of[RIOEA], fa)
 }
 
+func Get[
+	SRIOES ~func(S) RIOES,
+	RIOES ~func(R) IOES,
+	IOES ~func() ET.Either[E, P.Pair[S, S]],
+	S, R, E any,
+]() SRIOES {
+	return FromState[SRIOES](SG.Get[func(S) P.Pair[S, S]]())
+}
+
+func Gets[
+	SRIOEA ~func(S) RIOEA,
+	RIOEA ~func(R) IOEA,
+	IOEA ~func() ET.Either[E, P.Pair[A, S]],
+	S, R, E, A any,
+](f func(S) A) SRIOEA {
+	return FromState[SRIOEA](SG.Gets[func(S) P.Pair[A, S]](f))
+}
+
+func Modify[
+	SRIOEANY ~func(S) RIOEANY,
+	RIOEANY ~func(R) IOEANY,
+	IOEANY ~func() ET.Either[E, P.Pair[any, S]],
+	S, R, E any,
+](f func(S) S) SRIOEANY {
+	return FromState[SRIOEANY](SG.Modify[func(S) P.Pair[any, S]](f))
+}
+
+func Put[
+	SRIOEANY ~func(S) RIOEANY,
+	RIOEANY ~func(R) IOEANY,
+	IOEANY ~func() ET.Either[E, P.Pair[any, S]],
+	S, R, E any,
+](s S) SRIOEANY {
+	return Modify[SRIOEANY](F.Constant1[S](s))
+}
+
 // Combinators
 
 func Local[
@@ -285,6 +356,19 @@ func Asks[
 	}
 }
 
+func AsksState[
+	SRIOEA ~func(S) RIOEA,
+	RIOEA ~func(R) IOEA,
+	IOEA ~func() ET.Either[E, P.Pair[A, S]],
+	S, R, E, A any,
+](f func(R, S) A) SRIOEA {
+	return func(s S) RIOEA {
+		return G.Asks[func(R) P.Pair[A, S], RIOEA](func(r R) P.Pair[A, S] {
+			return P.MakePair(f(r, s), s)
+		})
+	}
+}
+
 func FromIOEitherK[
 	SRIOEB ~func(S) RIOEB,
 	RIOEB_IN ~func(R) IOEB_IN,
@@ -423,3 +507,85 @@ func ChainEitherK[
 ](f func(A) ET.Either[E, B]) func(SRIOEA) SRIOEB {
 	return Chain[SRIOEA](FromEitherK[SRIOEB](f))
 }
+
+func MonadMapLeft[
+	SRIOEA1 ~func(S) RIOEA1,
+	SRIOEA2 ~func(S) RIOEA2,
+	RIOEA1 ~func(R) IOEA1,
+	RIOEA2 ~func(R) IOEA2,
+	IOEA1 ~func() ET.Either[E1, P.Pair[A, S]],
+	IOEA2 ~func() ET.Either[E2, P.Pair[A, S]],
+	S, R, E1, E2, A any,
+](fa SRIOEA1, f func(E1) E2) SRIOEA2 {
+	return F.Flow2(
+		fa,
+		G.MapLeft[RIOEA1, RIOEA2](f),
+	)
+}
+
+func MapLeft[
+	SRIOEA1 ~func(S) RIOEA1,
+	SRIOEA2 ~func(S) RIOEA2,
+	RIOEA1 ~func(R) IOEA1,
+	RIOEA2 ~func(R) IOEA2,
+	IOEA1 ~func() ET.Either[E1, P.Pair[A, S]],
+	IOEA2 ~func() ET.Either[E2, P.Pair[A, S]],
+	S, R, E1, E2, A any,
+](f func(E1) E2) func(SRIOEA1) SRIOEA2 {
+	return F.Bind2nd(MonadMapLeft[SRIOEA1, SRIOEA2, RIOEA1, RIOEA2, IOEA1, IOEA2, S, R, E1, E2, A], f)
+}
+
+func MonadBiMap[
+	SRIOEA ~func(S) RIOEA,
+	SRIOEB ~func(S) RIOEB,
+	RIOEA ~func(R) IOEA,
+	RIOEB ~func(R) IOEB,
+	IOEA ~func() ET.Either[E1, P.Pair[A, S]],
+	IOEB ~func() ET.Either[E2, P.Pair[B, S]],
+	S, R, E1, E2, A, B any,
+](fa SRIOEA, f func(E1) E2, g func(A) B) SRIOEB {
+	return F.Flow2(
+		fa,
+		G.BiMap[RIOEA, RIOEB](f, P.Map[S](g)),
+	)
+}
+
+func BiMap[
+	SRIOEA ~func(S) RIOEA,
+	SRIOEB ~func(S) RIOEB,
+	RIOEA ~func(R) IOEA,
+	RIOEB ~func(R) IOEB,
+	IOEA ~func() ET.Either[E1, P.Pair[A, S]],
+	IOEB ~func() ET.Either[E2, P.Pair[B, S]],
+	S, R, E1, E2, A, B any,
+](f func(E1) E2, g func(A) B) func(SRIOEA) SRIOEB {
+	return func(fa SRIOEA) SRIOEB {
+		return MonadBiMap[SRIOEA, SRIOEB](fa, f, g)
+	}
+}
+
+func Eval[
+	SRIOEA ~func(S) RIOEA,
+	RIOEA ~func(R) IOEA,
+	RIOEA_OUT ~func(R) IOEA_OUT,
+	IOEA ~func() ET.Either[E, P.Pair[A, S]],
+	IOEA_OUT ~func() ET.Either[E, A],
+	S, R, E, A any,
+](s S) func(SRIOEA) RIOEA_OUT {
+	return func(fa SRIOEA) RIOEA_OUT {
+		return G.MonadMap[RIOEA, RIOEA_OUT](fa(s), P.Head[A, S])
+	}
+}
+
+func Exec[
+	SRIOEA ~func(S) RIOEA,
+	RIOEA ~func(R) IOEA,
+	RIOES_OUT ~func(R) IOES_OUT,
+	IOEA ~func() ET.Either[E, P.Pair[A, S]],
+	IOES_OUT ~func() ET.Either[E, S],
+	S, R, E, A any,
+](s S) func(SRIOEA) RIOES_OUT {
+	return func(fa SRIOEA) RIOES_OUT {
+		return G.MonadMap[RIOEA, RIOES_OUT](fa(s), P.Tail[A, S])
+	}
+}
@@ -19,6 +19,7 @@ import (
 	ET "github.com/IBM/fp-go/either"
 	IO "github.com/IBM/fp-go/io"
 	IOE "github.com/IBM/fp-go/ioeither"
+	P "github.com/IBM/fp-go/pair"
 	RD "github.com/IBM/fp-go/reader"
 	RE "github.com/IBM/fp-go/readereither"
 	RIOE "github.com/IBM/fp-go/readerioeither"
@@ -54,6 +55,18 @@ func Chain[S, R, E, A, B any](f func(A) StateReaderIOEither[S, R, E, B]) func(St
 	return G.Chain[StateReaderIOEither[S, R, E, A], StateReaderIOEither[S, R, E, B]](f)
 }
 
+// MonadChainFirst runs the computation returned by the function but keeps the original value. State changes
+// performed by that computation are retained.
+func MonadChainFirst[S, R, E, A, B any](fa StateReaderIOEither[S, R, E, A], f func(A) StateReaderIOEither[S, R, E, B]) StateReaderIOEither[S, R, E, A] {
+	return G.MonadChainFirst[StateReaderIOEither[S, R, E, A], StateReaderIOEither[S, R, E, B]](fa, f)
+}
+
+// ChainFirst runs the computation returned by the function but keeps the original value. State changes
+// performed by that computation are retained.
+func ChainFirst[S, R, E, A, B any](f func(A) StateReaderIOEither[S, R, E, B]) func(StateReaderIOEither[S, R, E, A]) StateReaderIOEither[S, R, E, A] {
+	return G.ChainFirst[StateReaderIOEither[S, R, E, A], StateReaderIOEither[S, R, E, B]](f)
+}
+
 func MonadAp[S, R, E, A, B any](fab StateReaderIOEither[S, R, E, func(A) B], fa StateReaderIOEither[S, R, E, A]) StateReaderIOEither[S, R, E, B] {
 	return G.MonadAp[StateReaderIOEither[S, R, E, A], StateReaderIOEither[S, R, E, B], StateReaderIOEither[S, R, E, func(A) B]](fab, fa)
 }
@@ -90,6 +103,26 @@ func FromEither[S, R, E, A any](ma ET.Either[E, A]) StateReaderIOEither[S, R, E,
 	return G.FromEither[StateReaderIOEither[S, R, E, A]](ma)
 }
 
+// Get returns the current state as the value
+func Get[S, R, E any]() StateReaderIOEither[S, R, E, S] {
+	return G.Get[StateReaderIOEither[S, R, E, S]]()
+}
+
+// Gets derives a value from the current state
+func Gets[S, R, E, A any](f func(S) A) StateReaderIOEither[S, R, E, A] {
+	return G.Gets[StateReaderIOEither[S, R, E, A]](f)
+}
+
+// Modify replaces the current state with the result of applying the function to it
+func Modify[S, R, E any](f func(S) S) StateReaderIOEither[S, R, E, any] {
+	return G.Modify[StateReaderIOEither[S, R, E, any]](f)
+}
+
+// Put replaces the current state with the given state
+func Put[S, R, E any](s S) StateReaderIOEither[S, R, E, any] {
+	return G.Put[StateReaderIOEither[S, R, E, any]](s)
+}
+
 // Combinators
 
 func Local[S, R1, R2, E, A, B any](f func(R2) R1) func(StateReaderIOEither[S, R1, E, A]) StateReaderIOEither[S, R2, E, A] {
@@ -102,6 +135,11 @@ func Asks[
 	return G.Asks[StateReaderIOEither[S, R, E, A]](f)
 }
 
+// AsksState derives a value from the context and the current state, the state remains unchanged
+func AsksState[S, R, E, A any](f func(R, S) A) StateReaderIOEither[S, R, E, A] {
+	return G.AsksState[StateReaderIOEither[S, R, E, A]](f)
+}
+
 func FromEitherK[S, R, E, A, B any](f func(A) ET.Either[E, B]) func(A) StateReaderIOEither[S, R, E, B] {
 	return G.FromEitherK[StateReaderIOEither[S, R, E, B]](f)
 }
@@ -143,3 +181,37 @@ func MonadChainEitherK[S, R, E, A, B any](ma StateReaderIOEither[S, R, E, A], f
 func ChainEitherK[S, R, E, A, B any](f func(A) ET.Either[E, B]) func(StateReaderIOEither[S, R, E, A]) StateReaderIOEither[S, R, E, B] {
 	return G.ChainEitherK[StateReaderIOEither[S, R, E, A], StateReaderIOEither[S, R, E, B]](f)
 }
+
+// MonadMapLeft applies a mapping function to the error channel. The state is left untouched, a failed computation
+// does not carry a state.
+func MonadMapLeft[S, R, E1, E2, A any](fa StateReaderIOEither[S, R, E1, A], f func(E1) E2) StateReaderIOEither[S, R, E2, A] {
+	return G.MonadMapLeft[StateReaderIOEither[S, R, E1, A], StateReaderIOEither[S, R, E2, A]](fa, f)
+}
+
+// MapLeft applies a mapping function to the error channel. The state is left untouched, a failed computation
+// does not carry a state.
+func MapLeft[S, R, E1, E2, A any](f func(E1) E2) func(StateReaderIOEither[S, R, E1, A]) StateReaderIOEither[S, R, E2, A] {
+	return G.MapLeft[StateReaderIOEither[S, R, E1, A], StateReaderIOEither[S, R, E2, A]](f)
+}
+
+// MonadBiMap maps a pair of functions over the two type arguments of the bifunctor. The state of a successful
+// computation is kept, a failed computation does not carry a state.
+func MonadBiMap[S, R, E1, E2, A, B any](fa StateReaderIOEither[S, R, E1, A], f func(E1) E2, g func(A) B) StateReaderIOEither[S, R, E2, B] {
+	return G.MonadBiMap[StateReaderIOEither[S, R, E1, A], StateReaderIOEither[S, R, E2, B]](fa, f, g)
+}
+
+// BiMap maps a pair of functions over the two type arguments of the bifunctor. The state of a successful
+// computation is kept, a failed computation does not carry a state.
+func BiMap[S, R, E1, E2, A, B any](f func(E1) E2, g func(A) B) func(StateReaderIOEither[S, R, E1, A]) StateReaderIOEither[S, R, E2, B] {
+	return G.BiMap[StateReaderIOEither[S, R, E1, A], StateReaderIOEither[S, R, E2, B]](f, g)
+}
+
+// Eval runs the computation with the initial state and returns the final value, discarding the final state
+func Eval[S, R, E, A any](s S) func(StateReaderIOEither[S, R, E, A]) RIOE.ReaderIOEither[R, E, A] {
+	return G.Eval[StateReaderIOEither[S, R, E, A], RIOE.ReaderIOEither[R, E, P.Pair[A, S]], RIOE.ReaderIOEither[R, E, A]](s)
+}
+
+// Exec runs the computation with the initial state and returns the final state, discarding the final value
+func Exec[S, R, E, A any](s S) func(StateReaderIOEither[S, R, E, A]) RIOE.ReaderIOEither[R, E, S] {
+	return G.Exec[StateReaderIOEither[S, R, E, A], RIOE.ReaderIOEither[R, E, P.Pair[A, S]], RIOE.ReaderIOEither[R, E, S]](s)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2024 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statereaderioeither
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	E "github.com/IBM/fp-go/either"
+	F "github.com/IBM/fp-go/function"
+	"github.com/IBM/fp-go/internal/utils"
+	P "github.com/IBM/fp-go/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapLeft(t *testing.T) {
+	called := false
+	f := MapLeft[int, context.Context, string, error, int](func(s string) error {
+		called = true
+		return fmt.Errorf("wrapped: %s", s)
+	})
+
+	g1 := F.Pipe1(
+		Right[int, context.Context, string](1),
+		f,
+	)
+	assert.Equal(t, E.Of[error](P.MakePair(1, 10)), g1(10)(context.Background())())
+	assert.False(t, called)
+
+	g2 := F.Pipe1(
+		Left[int, context.Context, int]("a"),
+		f,
+	)
+	assert.Equal(t, E.Left[P.Pair[int, int]](fmt.Errorf("wrapped: a")), g2(10)(context.Background())())
+	assert.True(t, called)
+}
+
+func TestBiMap(t *testing.T) {
+	eq := FromStrictEquals[int, context.Context, string, int]()(context.Background())(10)
+
+	right := Right[int, context.Context, string](1)
+	left := Left[int, context.Context, int]("a")
+
+	// identity law
+	id := BiMap[int, context.Context](F.Identity[string], F.Identity[int])
+	assert.True(t, eq.Equals(right, id(right)))
+	assert.True(t, eq.Equals(left, id(left)))
+
+	// consistency with Map and MapLeft
+	f := func(s string) string { return s + "!" }
+	bm := BiMap[int, context.Context](f, utils.Double)
+	seq := F.Flow2(
+		Map[int, context.Context, string](utils.Double),
+		MapLeft[int, context.Context, string, string, int](f),
+	)
+	assert.True(t, eq.Equals(seq(right), bm(right)))
+	assert.True(t, eq.Equals(seq(left), bm(left)))
+
+	assert.Equal(t, E.Of[string](P.MakePair(2, 10)), bm(right)(10)(context.Background())())
+	assert.Equal(t, E.Left[P.Pair[int, int]]("a!"), bm(left)(10)(context.Background())())
+}
+
+func TestAsksState(t *testing.T) {
+	g := AsksState[int, string, error](func(r string, s int) string {
+		return fmt.Sprintf("%s-%d", r, s)
+	})
+
+	assert.Equal(t, E.Of[error](P.MakePair("ctx-10", 10)), g(10)("ctx")())
+}
+
+func TestChainFirst(t *testing.T) {
+	count := func(a int) StateReaderIOEither[int, context.Context, error, string] {
+		return FromState[int, context.Context, error](func(s int) P.Pair[string, int] {
+			return P.MakePair(fmt.Sprintf("value %d", a), s+1)
+		})
+	}
+
+	g := F.Pipe1(
+		Of[int, context.Context, error](1),
+		ChainFirst(count),
+	)
+
+	assert.Equal(t, E.Of[error](P.MakePair(1, 11)), g(10)(context.Background())())
+}
+
+func TestStatePrimitives(t *testing.T) {
+	g := F.Pipe3(
+		Get[int, context.Context, error](),
+		Chain(func(s int) StateReaderIOEither[int, context.Context, error, any] {
+			return Put[int, context.Context, error](s * 2)
+		}),
+		Chain(func(any) StateReaderIOEither[int, context.Context, error, any] {
+			return Modify[int, context.Context, error](func(s int) int { return s + 1 })
+		}),
+		Chain(func(any) StateReaderIOEither[int, context.Context, error, string] {
+			return Gets[int, context.Context, error](func(s int) string { return fmt.Sprintf("state %d", s) })
+		}),
+	)
+
+	assert.Equal(t, E.Of[error](P.MakePair("state 21", 21)), g(10)(context.Background())())
+}
+
+func TestEvalExec(t *testing.T) {
+	count := 0
+	g := FromState[int, context.Context, error](func(s int) P.Pair[string, int] {
+		count++
+		return P.MakePair(fmt.Sprintf("state %d", s), s+1)
+	})
+
+	assert.Equal(t, E.Of[error]("state 10"), Eval[int, context.Context, error, string](10)(g)(context.Background())())
+	assert.Equal(t, 1, count)
+
+	assert.Equal(t, E.Of[error](11), Exec[int, context.Context, error, string](10)(g)(context.Background())())
+	assert.Equal(t, 2, count)
+}
@@ -73,3 +73,15 @@ func Format[T any](format string) func(t T) string {
 		return fmt.Sprintf(format, t)
 	}
 }
+
+// Split returns a function that splits a string around all occurrences of the separator
+func Split(sep string) func(string) []string {
+	return F.Bind2nd(strings.Split, sep)
+}
+
+// ReplaceAll returns a function that replaces all occurrences of old with new
+func ReplaceAll(old, new string) func(string) string {
+	return func(s string) string {
+		return strings.ReplaceAll(s, old, new)
+	}
+}
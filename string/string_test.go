@@ -48,3 +48,13 @@ func TestIncludes(t *testing.T) {
 	assert.False(t, Includes("bab")("a"))
 	assert.False(t, Includes("b")("a"))
 }
+
+func TestSplit(t *testing.T) {
+	assert.Equal(t, A.From("a", "b", "c"), Split(",")("a,b,c"))
+	assert.Equal(t, A.From("abc"), Split(",")("abc"))
+}
+
+func TestReplaceAll(t *testing.T) {
+	assert.Equal(t, "a-b-c", ReplaceAll(",", "-")("a,b,c"))
+	assert.Equal(t, "abc", ReplaceAll(",", "-")("abc"))
+}
@@ -19,6 +19,10 @@ import (
 	"encoding/json"
 	"testing"
 
+	M "github.com/IBM/fp-go/monoid"
+	N "github.com/IBM/fp-go/number"
+	O "github.com/IBM/fp-go/ord"
+	S "github.com/IBM/fp-go/string"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -55,3 +59,43 @@ func TestMarshalSmallArray(t *testing.T) {
 
 	assert.Equal(t, MakeTuple3("Carsten", 0, false), unmarshaled)
 }
+
+func TestMonoid(t *testing.T) {
+
+	// count and sum in one pass
+	m2 := Monoid2(N.MonoidSum[int](), N.MonoidSum[int]())
+	data := []Tuple2[int, int]{MakeTuple2(1, 10), MakeTuple2(1, 20), MakeTuple2(1, 30)}
+
+	assert.Equal(t, MakeTuple2(3, 60), M.ConcatAll(m2)(data))
+	assert.Equal(t, MakeTuple2(0, 0), M.ConcatAll(m2)(nil))
+
+	m3 := Monoid3(N.MonoidSum[int](), S.Monoid, M.Dual(S.Monoid))
+	assert.Equal(t, MakeTuple3(3, "ab", "ba"), m3.Concat(MakeTuple3(1, "a", "a"), MakeTuple3(2, "b", "b")))
+	assert.Equal(t, MakeTuple3(0, "", ""), m3.Empty())
+}
+
+func TestOrd2(t *testing.T) {
+	o := Ord2(S.Ord, O.FromStrictCompare[int]())
+
+	// ties in the first component are decided by the second one
+	assert.Equal(t, -1, o.Compare(MakeTuple2("a", 1), MakeTuple2("a", 2)))
+	assert.Equal(t, 1, o.Compare(MakeTuple2("a", 2), MakeTuple2("a", 1)))
+	assert.Equal(t, 0, o.Compare(MakeTuple2("a", 1), MakeTuple2("a", 1)))
+	// the first component takes precedence
+	assert.Equal(t, -1, o.Compare(MakeTuple2("a", 2), MakeTuple2("b", 1)))
+	assert.True(t, o.Equals(MakeTuple2("a", 1), MakeTuple2("a", 1)))
+	assert.False(t, o.Equals(MakeTuple2("a", 1), MakeTuple2("a", 2)))
+
+	sorted := O.Sort(o)([]Tuple2[string, int]{MakeTuple2("b", 1), MakeTuple2("a", 2), MakeTuple2("a", 1)})
+	assert.Equal(t, []Tuple2[string, int]{MakeTuple2("a", 1), MakeTuple2("a", 2), MakeTuple2("b", 1)}, sorted)
+}
+
+func TestOrd3(t *testing.T) {
+	o := Ord3(S.Ord, O.FromStrictCompare[int](), O.FromStrictCompare[int]())
+
+	// ties in the first two components are decided by the third one
+	assert.Equal(t, -1, o.Compare(MakeTuple3("a", 1, 1), MakeTuple3("a", 1, 2)))
+	assert.Equal(t, 1, o.Compare(MakeTuple3("a", 2, 1), MakeTuple3("a", 1, 2)))
+	assert.Equal(t, -1, o.Compare(MakeTuple3("a", 2, 2), MakeTuple3("b", 1, 1)))
+	assert.Equal(t, 0, o.Compare(MakeTuple3("a", 1, 1), MakeTuple3("a", 1, 1)))
+}
@@ -113,6 +113,10 @@ func Flatten[GAA ~func() P.Pair[GA, W], GA ~func() P.Pair[A, W], W, A any](s SG.
 	return MonadChain[GA, GAA, func(GA) GA](s, mma, F.Identity[GA])
 }
 
+func Run[GA ~func() P.Pair[A, W], W, A any](fa GA) P.Pair[A, W] {
+	return fa()
+}
+
 func Execute[GA ~func() P.Pair[A, W], W, A any](fa GA) W {
 	return P.Tail(fa())
 }
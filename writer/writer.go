@@ -81,6 +81,11 @@ func Flatten[W, A any](s SG.Semigroup[W], mma Writer[W, Writer[W, A]]) Writer[W,
 	return G.Flatten[Writer[W, Writer[W, A]], Writer[W, A]](s, mma)
 }
 
+// Run extracts both the value and the accumulator
+func Run[W, A any](fa Writer[W, A]) P.Pair[A, W] {
+	return G.Run(fa)
+}
+
 // Execute extracts the accumulator
 func Execute[W, A any](fa Writer[W, A]) W {
 	return G.Execute(fa)
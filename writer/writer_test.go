@@ -17,10 +17,12 @@ package writer
 
 import (
 	"fmt"
+	"testing"
 
 	A "github.com/IBM/fp-go/array"
 	F "github.com/IBM/fp-go/function"
 	P "github.com/IBM/fp-go/pair"
+	"github.com/stretchr/testify/assert"
 )
 
 func doubleAndLog(data int) Writer[[]string, int] {
@@ -43,3 +45,34 @@ func ExampleWriter_logging() {
 
 	// Output: [Doubled 10 -> 20 Doubled 20 -> 40]
 }
+
+func logged(msg string) func(int) Writer[[]string, int] {
+	return func(n int) Writer[[]string, int] {
+		return F.Pipe1(
+			Tell(A.Of(fmt.Sprintf("%s %d", msg, n))),
+			Map[[]string](F.Constant1[any](n+1)),
+		)
+	}
+}
+
+func TestTellAndChain(t *testing.T) {
+	res := F.Pipe3(
+		Of[int](monoid, 1),
+		Chain(sg, logged("first")),
+		Chain(sg, logged("second")),
+		Run[[]string, int],
+	)
+
+	assert.Equal(t, P.MakePair(3, A.From("first 1", "second 2")), res)
+}
+
+func TestListen(t *testing.T) {
+	res := F.Pipe2(
+		Of[int](monoid, 1),
+		Chain(sg, logged("first")),
+		Listen[[]string, int],
+	)
+
+	assert.Equal(t, P.MakePair(2, A.From("first 1")), Evaluate(res))
+	assert.Equal(t, A.From("first 1"), Execute(res))
+}